@@ -62,6 +62,7 @@ const (
 	TerminalService_TransferFile_FullMethodName                      = "/teleport.lib.teleterm.v1.TerminalService/TransferFile"
 	TerminalService_ReportUsageEvent_FullMethodName                  = "/teleport.lib.teleterm.v1.TerminalService/ReportUsageEvent"
 	TerminalService_UpdateHeadlessAuthenticationState_FullMethodName = "/teleport.lib.teleterm.v1.TerminalService/UpdateHeadlessAuthenticationState"
+	TerminalService_RegisterHeadlessAction_FullMethodName            = "/teleport.lib.teleterm.v1.TerminalService/RegisterHeadlessAction"
 	TerminalService_CreateConnectMyComputerRole_FullMethodName       = "/teleport.lib.teleterm.v1.TerminalService/CreateConnectMyComputerRole"
 	TerminalService_CreateConnectMyComputerNodeToken_FullMethodName  = "/teleport.lib.teleterm.v1.TerminalService/CreateConnectMyComputerNodeToken"
 	TerminalService_DeleteConnectMyComputerToken_FullMethodName      = "/teleport.lib.teleterm.v1.TerminalService/DeleteConnectMyComputerToken"
@@ -160,6 +161,11 @@ type TerminalServiceClient interface {
 	// UpdateHeadlessAuthenticationState updates a headless authentication resource's state.
 	// An MFA challenge will be prompted when approving a headless authentication.
 	UpdateHeadlessAuthenticationState(ctx context.Context, in *UpdateHeadlessAuthenticationStateRequest, opts ...grpc.CallOption) (*UpdateHeadlessAuthenticationStateResponse, error)
+	// RegisterHeadlessAction records that a headless action, such as running
+	// "tsh ls --headless" from an app-managed terminal, was just initiated from
+	// this device. Pending headless authentications received shortly after for
+	// the same cluster are sent with the expected flag set.
+	RegisterHeadlessAction(ctx context.Context, in *RegisterHeadlessActionRequest, opts ...grpc.CallOption) (*RegisterHeadlessActionResponse, error)
 	// CreateConnectMyComputerRole creates a role which allows access to nodes with the label
 	// teleport.dev/connect-my-computer/owner: <cluster user> and allows logging in to those nodes as
 	// the current system user.
@@ -497,6 +503,15 @@ func (c *terminalServiceClient) UpdateHeadlessAuthenticationState(ctx context.Co
 	return out, nil
 }
 
+func (c *terminalServiceClient) RegisterHeadlessAction(ctx context.Context, in *RegisterHeadlessActionRequest, opts ...grpc.CallOption) (*RegisterHeadlessActionResponse, error) {
+	out := new(RegisterHeadlessActionResponse)
+	err := c.cc.Invoke(ctx, TerminalService_RegisterHeadlessAction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *terminalServiceClient) CreateConnectMyComputerRole(ctx context.Context, in *CreateConnectMyComputerRoleRequest, opts ...grpc.CallOption) (*CreateConnectMyComputerRoleResponse, error) {
 	out := new(CreateConnectMyComputerRoleResponse)
 	err := c.cc.Invoke(ctx, TerminalService_CreateConnectMyComputerRole_FullMethodName, in, out, opts...)
@@ -649,6 +664,11 @@ type TerminalServiceServer interface {
 	// UpdateHeadlessAuthenticationState updates a headless authentication resource's state.
 	// An MFA challenge will be prompted when approving a headless authentication.
 	UpdateHeadlessAuthenticationState(context.Context, *UpdateHeadlessAuthenticationStateRequest) (*UpdateHeadlessAuthenticationStateResponse, error)
+	// RegisterHeadlessAction records that a headless action, such as running
+	// "tsh ls --headless" from an app-managed terminal, was just initiated from
+	// this device. Pending headless authentications received shortly after for
+	// the same cluster are sent with the expected flag set.
+	RegisterHeadlessAction(context.Context, *RegisterHeadlessActionRequest) (*RegisterHeadlessActionResponse, error)
 	// CreateConnectMyComputerRole creates a role which allows access to nodes with the label
 	// teleport.dev/connect-my-computer/owner: <cluster user> and allows logging in to those nodes as
 	// the current system user.
@@ -764,6 +784,9 @@ func (UnimplementedTerminalServiceServer) ReportUsageEvent(context.Context, *Rep
 func (UnimplementedTerminalServiceServer) UpdateHeadlessAuthenticationState(context.Context, *UpdateHeadlessAuthenticationStateRequest) (*UpdateHeadlessAuthenticationStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateHeadlessAuthenticationState not implemented")
 }
+func (UnimplementedTerminalServiceServer) RegisterHeadlessAction(context.Context, *RegisterHeadlessActionRequest) (*RegisterHeadlessActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterHeadlessAction not implemented")
+}
 func (UnimplementedTerminalServiceServer) CreateConnectMyComputerRole(context.Context, *CreateConnectMyComputerRoleRequest) (*CreateConnectMyComputerRoleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateConnectMyComputerRole not implemented")
 }
@@ -1331,6 +1354,24 @@ func _TerminalService_UpdateHeadlessAuthenticationState_Handler(srv interface{},
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TerminalService_RegisterHeadlessAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterHeadlessActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TerminalServiceServer).RegisterHeadlessAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TerminalService_RegisterHeadlessAction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TerminalServiceServer).RegisterHeadlessAction(ctx, req.(*RegisterHeadlessActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TerminalService_CreateConnectMyComputerRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateConnectMyComputerRoleRequest)
 	if err := dec(in); err != nil {
@@ -1572,6 +1613,10 @@ var TerminalService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateHeadlessAuthenticationState",
 			Handler:    _TerminalService_UpdateHeadlessAuthenticationState_Handler,
 		},
+		{
+			MethodName: "RegisterHeadlessAction",
+			Handler:    _TerminalService_RegisterHeadlessAction_Handler,
+		},
 		{
 			MethodName: "CreateConnectMyComputerRole",
 			Handler:    _TerminalService_CreateConnectMyComputerRole_Handler,
@@ -45,6 +45,7 @@ interface ITerminalServiceService extends grpc.ServiceDefinition<grpc.UntypedSer
     transferFile: ITerminalServiceService_ITransferFile;
     reportUsageEvent: ITerminalServiceService_IReportUsageEvent;
     updateHeadlessAuthenticationState: ITerminalServiceService_IUpdateHeadlessAuthenticationState;
+    registerHeadlessAction: ITerminalServiceService_IRegisterHeadlessAction;
     createConnectMyComputerRole: ITerminalServiceService_ICreateConnectMyComputerRole;
     createConnectMyComputerNodeToken: ITerminalServiceService_ICreateConnectMyComputerNodeToken;
     deleteConnectMyComputerToken: ITerminalServiceService_IDeleteConnectMyComputerToken;
@@ -315,6 +316,15 @@ interface ITerminalServiceService_IUpdateHeadlessAuthenticationState extends grp
     responseSerialize: grpc.serialize<teleport_lib_teleterm_v1_service_pb.UpdateHeadlessAuthenticationStateResponse>;
     responseDeserialize: grpc.deserialize<teleport_lib_teleterm_v1_service_pb.UpdateHeadlessAuthenticationStateResponse>;
 }
+interface ITerminalServiceService_IRegisterHeadlessAction extends grpc.MethodDefinition<teleport_lib_teleterm_v1_service_pb.RegisterHeadlessActionRequest, teleport_lib_teleterm_v1_service_pb.RegisterHeadlessActionResponse> {
+    path: "/teleport.lib.teleterm.v1.TerminalService/RegisterHeadlessAction";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<teleport_lib_teleterm_v1_service_pb.RegisterHeadlessActionRequest>;
+    requestDeserialize: grpc.deserialize<teleport_lib_teleterm_v1_service_pb.RegisterHeadlessActionRequest>;
+    responseSerialize: grpc.serialize<teleport_lib_teleterm_v1_service_pb.RegisterHeadlessActionResponse>;
+    responseDeserialize: grpc.deserialize<teleport_lib_teleterm_v1_service_pb.RegisterHeadlessActionResponse>;
+}
 interface ITerminalServiceService_ICreateConnectMyComputerRole extends grpc.MethodDefinition<teleport_lib_teleterm_v1_service_pb.CreateConnectMyComputerRoleRequest, teleport_lib_teleterm_v1_service_pb.CreateConnectMyComputerRoleResponse> {
     path: "/teleport.lib.teleterm.v1.TerminalService/CreateConnectMyComputerRole";
     requestStream: false;
@@ -411,6 +421,7 @@ export interface ITerminalServiceServer {
     transferFile: grpc.handleServerStreamingCall<teleport_lib_teleterm_v1_service_pb.FileTransferRequest, teleport_lib_teleterm_v1_service_pb.FileTransferProgress>;
     reportUsageEvent: grpc.handleUnaryCall<teleport_lib_teleterm_v1_usage_events_pb.ReportUsageEventRequest, teleport_lib_teleterm_v1_service_pb.EmptyResponse>;
     updateHeadlessAuthenticationState: grpc.handleUnaryCall<teleport_lib_teleterm_v1_service_pb.UpdateHeadlessAuthenticationStateRequest, teleport_lib_teleterm_v1_service_pb.UpdateHeadlessAuthenticationStateResponse>;
+    registerHeadlessAction: grpc.handleUnaryCall<teleport_lib_teleterm_v1_service_pb.RegisterHeadlessActionRequest, teleport_lib_teleterm_v1_service_pb.RegisterHeadlessActionResponse>;
     createConnectMyComputerRole: grpc.handleUnaryCall<teleport_lib_teleterm_v1_service_pb.CreateConnectMyComputerRoleRequest, teleport_lib_teleterm_v1_service_pb.CreateConnectMyComputerRoleResponse>;
     createConnectMyComputerNodeToken: grpc.handleUnaryCall<teleport_lib_teleterm_v1_service_pb.CreateConnectMyComputerNodeTokenRequest, teleport_lib_teleterm_v1_service_pb.CreateConnectMyComputerNodeTokenResponse>;
     deleteConnectMyComputerToken: grpc.handleUnaryCall<teleport_lib_teleterm_v1_service_pb.DeleteConnectMyComputerTokenRequest, teleport_lib_teleterm_v1_service_pb.DeleteConnectMyComputerTokenResponse>;
@@ -507,6 +518,9 @@ export interface ITerminalServiceClient {
     updateHeadlessAuthenticationState(request: teleport_lib_teleterm_v1_service_pb.UpdateHeadlessAuthenticationStateRequest, callback: (error: grpc.ServiceError | null, response: teleport_lib_teleterm_v1_service_pb.UpdateHeadlessAuthenticationStateResponse) => void): grpc.ClientUnaryCall;
     updateHeadlessAuthenticationState(request: teleport_lib_teleterm_v1_service_pb.UpdateHeadlessAuthenticationStateRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: teleport_lib_teleterm_v1_service_pb.UpdateHeadlessAuthenticationStateResponse) => void): grpc.ClientUnaryCall;
     updateHeadlessAuthenticationState(request: teleport_lib_teleterm_v1_service_pb.UpdateHeadlessAuthenticationStateRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: teleport_lib_teleterm_v1_service_pb.UpdateHeadlessAuthenticationStateResponse) => void): grpc.ClientUnaryCall;
+    registerHeadlessAction(request: teleport_lib_teleterm_v1_service_pb.RegisterHeadlessActionRequest, callback: (error: grpc.ServiceError | null, response: teleport_lib_teleterm_v1_service_pb.RegisterHeadlessActionResponse) => void): grpc.ClientUnaryCall;
+    registerHeadlessAction(request: teleport_lib_teleterm_v1_service_pb.RegisterHeadlessActionRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: teleport_lib_teleterm_v1_service_pb.RegisterHeadlessActionResponse) => void): grpc.ClientUnaryCall;
+    registerHeadlessAction(request: teleport_lib_teleterm_v1_service_pb.RegisterHeadlessActionRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: teleport_lib_teleterm_v1_service_pb.RegisterHeadlessActionResponse) => void): grpc.ClientUnaryCall;
     createConnectMyComputerRole(request: teleport_lib_teleterm_v1_service_pb.CreateConnectMyComputerRoleRequest, callback: (error: grpc.ServiceError | null, response: teleport_lib_teleterm_v1_service_pb.CreateConnectMyComputerRoleResponse) => void): grpc.ClientUnaryCall;
     createConnectMyComputerRole(request: teleport_lib_teleterm_v1_service_pb.CreateConnectMyComputerRoleRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: teleport_lib_teleterm_v1_service_pb.CreateConnectMyComputerRoleResponse) => void): grpc.ClientUnaryCall;
     createConnectMyComputerRole(request: teleport_lib_teleterm_v1_service_pb.CreateConnectMyComputerRoleRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: teleport_lib_teleterm_v1_service_pb.CreateConnectMyComputerRoleResponse) => void): grpc.ClientUnaryCall;
@@ -617,6 +631,9 @@ export class TerminalServiceClient extends grpc.Client implements ITerminalServi
     public updateHeadlessAuthenticationState(request: teleport_lib_teleterm_v1_service_pb.UpdateHeadlessAuthenticationStateRequest, callback: (error: grpc.ServiceError | null, response: teleport_lib_teleterm_v1_service_pb.UpdateHeadlessAuthenticationStateResponse) => void): grpc.ClientUnaryCall;
     public updateHeadlessAuthenticationState(request: teleport_lib_teleterm_v1_service_pb.UpdateHeadlessAuthenticationStateRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: teleport_lib_teleterm_v1_service_pb.UpdateHeadlessAuthenticationStateResponse) => void): grpc.ClientUnaryCall;
     public updateHeadlessAuthenticationState(request: teleport_lib_teleterm_v1_service_pb.UpdateHeadlessAuthenticationStateRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: teleport_lib_teleterm_v1_service_pb.UpdateHeadlessAuthenticationStateResponse) => void): grpc.ClientUnaryCall;
+    public registerHeadlessAction(request: teleport_lib_teleterm_v1_service_pb.RegisterHeadlessActionRequest, callback: (error: grpc.ServiceError | null, response: teleport_lib_teleterm_v1_service_pb.RegisterHeadlessActionResponse) => void): grpc.ClientUnaryCall;
+    public registerHeadlessAction(request: teleport_lib_teleterm_v1_service_pb.RegisterHeadlessActionRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: teleport_lib_teleterm_v1_service_pb.RegisterHeadlessActionResponse) => void): grpc.ClientUnaryCall;
+    public registerHeadlessAction(request: teleport_lib_teleterm_v1_service_pb.RegisterHeadlessActionRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: teleport_lib_teleterm_v1_service_pb.RegisterHeadlessActionResponse) => void): grpc.ClientUnaryCall;
     public createConnectMyComputerRole(request: teleport_lib_teleterm_v1_service_pb.CreateConnectMyComputerRoleRequest, callback: (error: grpc.ServiceError | null, response: teleport_lib_teleterm_v1_service_pb.CreateConnectMyComputerRoleResponse) => void): grpc.ClientUnaryCall;
     public createConnectMyComputerRole(request: teleport_lib_teleterm_v1_service_pb.CreateConnectMyComputerRoleRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: teleport_lib_teleterm_v1_service_pb.CreateConnectMyComputerRoleResponse) => void): grpc.ClientUnaryCall;
     public createConnectMyComputerRole(request: teleport_lib_teleterm_v1_service_pb.CreateConnectMyComputerRoleRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: teleport_lib_teleterm_v1_service_pb.CreateConnectMyComputerRoleResponse) => void): grpc.ClientUnaryCall;
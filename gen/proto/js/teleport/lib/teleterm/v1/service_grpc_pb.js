@@ -588,6 +588,28 @@ function deserialize_teleport_lib_teleterm_v1_RemoveGatewayRequest(buffer_arg) {
   return teleport_lib_teleterm_v1_service_pb.RemoveGatewayRequest.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_teleport_lib_teleterm_v1_RegisterHeadlessActionRequest(arg) {
+  if (!(arg instanceof teleport_lib_teleterm_v1_service_pb.RegisterHeadlessActionRequest)) {
+    throw new Error('Expected argument of type teleport.lib.teleterm.v1.RegisterHeadlessActionRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_teleport_lib_teleterm_v1_RegisterHeadlessActionRequest(buffer_arg) {
+  return teleport_lib_teleterm_v1_service_pb.RegisterHeadlessActionRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_teleport_lib_teleterm_v1_RegisterHeadlessActionResponse(arg) {
+  if (!(arg instanceof teleport_lib_teleterm_v1_service_pb.RegisterHeadlessActionResponse)) {
+    throw new Error('Expected argument of type teleport.lib.teleterm.v1.RegisterHeadlessActionResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_teleport_lib_teleterm_v1_RegisterHeadlessActionResponse(buffer_arg) {
+  return teleport_lib_teleterm_v1_service_pb.RegisterHeadlessActionResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_teleport_lib_teleterm_v1_ReportUsageEventRequest(arg) {
   if (!(arg instanceof teleport_lib_teleterm_v1_usage_events_pb.ReportUsageEventRequest)) {
     throw new Error('Expected argument of type teleport.lib.teleterm.v1.ReportUsageEventRequest');
@@ -1092,6 +1114,21 @@ updateHeadlessAuthenticationState: {
     responseSerialize: serialize_teleport_lib_teleterm_v1_UpdateHeadlessAuthenticationStateResponse,
     responseDeserialize: deserialize_teleport_lib_teleterm_v1_UpdateHeadlessAuthenticationStateResponse,
   },
+  // RegisterHeadlessAction records that a headless action, such as running
+// "tsh ls --headless" from an app-managed terminal, was just initiated from
+// this device. Pending headless authentications received shortly after for
+// the same cluster are sent with the expected flag set.
+registerHeadlessAction: {
+    path: '/teleport.lib.teleterm.v1.TerminalService/RegisterHeadlessAction',
+    requestStream: false,
+    responseStream: false,
+    requestType: teleport_lib_teleterm_v1_service_pb.RegisterHeadlessActionRequest,
+    responseType: teleport_lib_teleterm_v1_service_pb.RegisterHeadlessActionResponse,
+    requestSerialize: serialize_teleport_lib_teleterm_v1_RegisterHeadlessActionRequest,
+    requestDeserialize: deserialize_teleport_lib_teleterm_v1_RegisterHeadlessActionRequest,
+    responseSerialize: serialize_teleport_lib_teleterm_v1_RegisterHeadlessActionResponse,
+    responseDeserialize: deserialize_teleport_lib_teleterm_v1_RegisterHeadlessActionResponse,
+  },
   // CreateConnectMyComputerRole creates a role which allows access to nodes with the label
 // teleport.dev/connect-my-computer/owner: <cluster user> and allows logging in to those nodes as
 // the current system user.
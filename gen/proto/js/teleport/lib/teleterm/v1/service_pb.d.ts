@@ -1360,6 +1360,48 @@ export namespace UpdateHeadlessAuthenticationStateResponse {
     }
 }
 
+export class RegisterHeadlessActionRequest extends jspb.Message { 
+    getRootClusterUri(): string;
+    setRootClusterUri(value: string): RegisterHeadlessActionRequest;
+
+    getClientIp(): string;
+    setClientIp(value: string): RegisterHeadlessActionRequest;
+
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): RegisterHeadlessActionRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: RegisterHeadlessActionRequest): RegisterHeadlessActionRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: RegisterHeadlessActionRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): RegisterHeadlessActionRequest;
+    static deserializeBinaryFromReader(message: RegisterHeadlessActionRequest, reader: jspb.BinaryReader): RegisterHeadlessActionRequest;
+}
+
+export namespace RegisterHeadlessActionRequest {
+    export type AsObject = {
+        rootClusterUri: string,
+        clientIp: string,
+    }
+}
+
+export class RegisterHeadlessActionResponse extends jspb.Message { 
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): RegisterHeadlessActionResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: RegisterHeadlessActionResponse): RegisterHeadlessActionResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: RegisterHeadlessActionResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): RegisterHeadlessActionResponse;
+    static deserializeBinaryFromReader(message: RegisterHeadlessActionResponse, reader: jspb.BinaryReader): RegisterHeadlessActionResponse;
+}
+
+export namespace RegisterHeadlessActionResponse {
+    export type AsObject = {
+    }
+}
+
 export class CreateConnectMyComputerRoleRequest extends jspb.Message { 
     getRootClusterUri(): string;
     setRootClusterUri(value: string): CreateConnectMyComputerRoleRequest;
@@ -176,6 +176,9 @@ export class SendPendingHeadlessAuthenticationRequest extends jspb.Message {
     getHeadlessAuthenticationClientIp(): string;
     setHeadlessAuthenticationClientIp(value: string): SendPendingHeadlessAuthenticationRequest;
 
+    getExpected(): boolean;
+    setExpected(value: boolean): SendPendingHeadlessAuthenticationRequest;
+
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): SendPendingHeadlessAuthenticationRequest.AsObject;
@@ -192,6 +195,7 @@ export namespace SendPendingHeadlessAuthenticationRequest {
         rootClusterUri: string,
         headlessAuthenticationId: string,
         headlessAuthenticationClientIp: string,
+        expected: boolean,
     }
 }
 
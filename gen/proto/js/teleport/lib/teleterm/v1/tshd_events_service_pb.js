@@ -1161,7 +1161,8 @@ proto.teleport.lib.teleterm.v1.SendPendingHeadlessAuthenticationRequest.toObject
   var f, obj = {
     rootClusterUri: jspb.Message.getFieldWithDefault(msg, 1, ""),
     headlessAuthenticationId: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    headlessAuthenticationClientIp: jspb.Message.getFieldWithDefault(msg, 3, "")
+    headlessAuthenticationClientIp: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    expected: jspb.Message.getBooleanFieldWithDefault(msg, 4, false)
   };
 
   if (includeInstance) {
@@ -1210,6 +1211,10 @@ proto.teleport.lib.teleterm.v1.SendPendingHeadlessAuthenticationRequest.deserial
       var value = /** @type {string} */ (reader.readString());
       msg.setHeadlessAuthenticationClientIp(value);
       break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setExpected(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -1260,6 +1265,13 @@ proto.teleport.lib.teleterm.v1.SendPendingHeadlessAuthenticationRequest.serializ
       f
     );
   }
+  f = message.getExpected();
+  if (f) {
+    writer.writeBool(
+      4,
+      f
+    );
+  }
 };
 
 
@@ -1317,6 +1329,24 @@ proto.teleport.lib.teleterm.v1.SendPendingHeadlessAuthenticationRequest.prototyp
 };
 
 
+/**
+ * optional bool expected = 4;
+ * @return {boolean}
+ */
+proto.teleport.lib.teleterm.v1.SendPendingHeadlessAuthenticationRequest.prototype.getExpected = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.teleport.lib.teleterm.v1.SendPendingHeadlessAuthenticationRequest} returns this
+ */
+proto.teleport.lib.teleterm.v1.SendPendingHeadlessAuthenticationRequest.prototype.setExpected = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
+};
+
+
 
 
 
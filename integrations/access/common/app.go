@@ -243,6 +243,11 @@ func (a *BaseApp) onPendingRequest(ctx context.Context, req types.AccessRequest)
 
 	reqID := req.GetName()
 
+	if notify, reason := a.Conf.GetNotificationGate().ShouldNotify(req); !notify {
+		log.WithField("reason", reason).Info("Skipping notifications for the access request")
+		return nil
+	}
+
 	resourceNames, err := a.getResourceNames(ctx, req)
 	if err != nil {
 		return trace.Wrap(err)
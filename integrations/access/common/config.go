@@ -30,6 +30,7 @@ type PluginConfiguration interface {
 	GetRecipients() RawRecipientsMap
 	NewBot(clusterName string, webProxyAddr string) (MessagingBot, error)
 	GetPluginType() types.PluginType
+	GetNotificationGate() NotificationGate
 }
 
 type BaseConfig struct {
@@ -37,12 +38,20 @@ type BaseConfig struct {
 	Recipients RawRecipientsMap   `toml:"role_to_recipients"`
 	Log        logger.Config      `toml:"log"`
 	PluginType types.PluginType
+	// NotificationGate filters which access requests generate notifications.
+	// The zero value notifies for every request.
+	NotificationGate NotificationGate `toml:"notification_gate"`
 }
 
 func (c BaseConfig) GetRecipients() RawRecipientsMap {
 	return c.Recipients
 }
 
+// GetNotificationGate returns the configured notification gate.
+func (c BaseConfig) GetNotificationGate() NotificationGate {
+	return c.NotificationGate
+}
+
 func (c BaseConfig) GetTeleportClient(ctx context.Context) (teleport.Client, error) {
 	return c.Teleport.NewClient(ctx)
 }
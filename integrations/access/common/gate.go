@@ -0,0 +1,87 @@
+/*
+Copyright 2023 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"fmt"
+	"time"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/gravitational/teleport/api/types"
+)
+
+// ReqAnnotationRiskLabel is the request annotation key at which risk tags
+// assigned by the requested roles are stored for access plugins.
+const ReqAnnotationRiskLabel = "/risk"
+
+// NotificationGate decides whether a pending access request warrants a
+// notification or incident at all. Routine low-risk requests can be filtered
+// out to reduce notification noise while sensitive requests are still covered.
+//
+// A request is notified when it matches at least one of the configured
+// criteria. A zero gate (no criteria configured) notifies for every request.
+type NotificationGate struct {
+	// RiskTags are values of the risk system annotation
+	// (teleport.dev/risk) that mark a request as worth notifying.
+	RiskTags []string `toml:"risk_tags"`
+	// MinDuration is the minimum requested access duration that triggers a
+	// notification.
+	MinDuration time.Duration `toml:"min_duration"`
+	// SensitiveKinds are requested resource kinds (e.g. "db", "kube_cluster")
+	// that trigger a notification.
+	SensitiveKinds []string `toml:"sensitive_kinds"`
+
+	// Predicate is an optional custom predicate consulted instead of the
+	// built-in criteria when set.
+	Predicate func(req types.AccessRequest) bool `toml:"-"`
+}
+
+// ShouldNotify reports whether the given access request should generate
+// notifications along with a human-readable reason for the decision.
+func (g NotificationGate) ShouldNotify(req types.AccessRequest) (bool, string) {
+	if g.Predicate != nil {
+		if g.Predicate(req) {
+			return true, "notification predicate matched"
+		}
+		return false, "notification predicate did not match"
+	}
+
+	if len(g.RiskTags) == 0 && g.MinDuration == 0 && len(g.SensitiveKinds) == 0 {
+		return true, "no notification criteria configured"
+	}
+
+	for _, tag := range req.GetSystemAnnotations()[types.TeleportNamespace+ReqAnnotationRiskLabel] {
+		if slices.Contains(g.RiskTags, tag) {
+			return true, fmt.Sprintf("request carries risk tag %q", tag)
+		}
+	}
+
+	if g.MinDuration > 0 && !req.GetAccessExpiry().IsZero() {
+		if duration := req.GetAccessExpiry().Sub(req.GetCreationTime()); duration >= g.MinDuration {
+			return true, fmt.Sprintf("requested duration %s exceeds %s", duration, g.MinDuration)
+		}
+	}
+
+	for _, resource := range req.GetRequestedResourceIDs() {
+		if slices.Contains(g.SensitiveKinds, resource.Kind) {
+			return true, fmt.Sprintf("request includes sensitive resource kind %q", resource.Kind)
+		}
+	}
+
+	return false, "request matched no notification criteria"
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2023 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitational/teleport/api/types"
+)
+
+func TestNotificationGateZeroValue(t *testing.T) {
+	req, err := types.NewAccessRequest("someRequest", "someUser", "editor")
+	require.NoError(t, err)
+
+	notify, _ := NotificationGate{}.ShouldNotify(req)
+	assert.True(t, notify)
+}
+
+func TestNotificationGateRiskTags(t *testing.T) {
+	gate := NotificationGate{RiskTags: []string{"high"}}
+
+	req, err := types.NewAccessRequest("someRequest", "someUser", "editor")
+	require.NoError(t, err)
+
+	notify, _ := gate.ShouldNotify(req)
+	assert.False(t, notify)
+
+	req.SetSystemAnnotations(map[string][]string{
+		types.TeleportNamespace + ReqAnnotationRiskLabel: {"low", "high"},
+	})
+	notify, _ = gate.ShouldNotify(req)
+	assert.True(t, notify)
+}
+
+func TestNotificationGateMinDuration(t *testing.T) {
+	gate := NotificationGate{MinDuration: 8 * time.Hour}
+
+	req, err := types.NewAccessRequest("someRequest", "someUser", "editor")
+	require.NoError(t, err)
+	created := time.Date(2023, 6, 1, 13, 0, 0, 0, time.UTC)
+	req.SetCreationTime(created)
+
+	req.SetAccessExpiry(created.Add(time.Hour))
+	notify, _ := gate.ShouldNotify(req)
+	assert.False(t, notify)
+
+	req.SetAccessExpiry(created.Add(24 * time.Hour))
+	notify, _ = gate.ShouldNotify(req)
+	assert.True(t, notify)
+}
+
+func TestNotificationGateSensitiveKinds(t *testing.T) {
+	gate := NotificationGate{SensitiveKinds: []string{types.KindDatabase}}
+
+	req, err := types.NewAccessRequestWithResources("someRequest", "someUser", nil, []types.ResourceID{
+		{ClusterName: "someCluster", Kind: types.KindNode, Name: "someNode"},
+	})
+	require.NoError(t, err)
+	notify, _ := gate.ShouldNotify(req)
+	assert.False(t, notify)
+
+	req, err = types.NewAccessRequestWithResources("someRequest", "someUser", nil, []types.ResourceID{
+		{ClusterName: "someCluster", Kind: types.KindDatabase, Name: "someDB"},
+	})
+	require.NoError(t, err)
+	notify, _ = gate.ShouldNotify(req)
+	assert.True(t, notify)
+}
+
+func TestNotificationGatePredicate(t *testing.T) {
+	gate := NotificationGate{
+		RiskTags: []string{"high"},
+		Predicate: func(req types.AccessRequest) bool {
+			return req.GetUser() == "someUser"
+		},
+	}
+
+	req, err := types.NewAccessRequest("someRequest", "someUser", "editor")
+	require.NoError(t, err)
+
+	// The predicate overrides the built-in criteria.
+	notify, _ := gate.ShouldNotify(req)
+	assert.True(t, notify)
+
+	req, err = types.NewAccessRequest("someRequest", "otherUser", "editor")
+	require.NoError(t, err)
+	notify, _ = gate.ShouldNotify(req)
+	assert.False(t, notify)
+}
@@ -51,6 +51,9 @@ const (
 	modifyPluginDataBackoffBase = time.Millisecond
 	// modifyPluginDataBackoffMax is a backoff threshold
 	modifyPluginDataBackoffMax = time.Second
+	// reconcileIncidentsLimit bounds the number of open incidents examined
+	// during startup reconciliation.
+	reconcileIncidentsLimit = 100
 )
 
 // errMissingAnnotation is used for cases where request annotations are not set
@@ -128,6 +131,14 @@ func (a *App) run(ctx context.Context) error {
 		log.Error("ServiceNow plugin is not ready")
 	}
 
+	if ok && a.conf.ReconcileOnStartup {
+		// Reconciliation is best-effort: a failure here must not bring the
+		// plugin down since the watcher is already serving new events.
+		if err := a.reconcileIncidents(ctx); err != nil {
+			log.WithError(err).Error("Failed to reconcile open incidents")
+		}
+	}
+
 	<-watcherJob.Done()
 
 	return trace.Wrap(watcherJob.Err())
@@ -225,6 +236,53 @@ func (a *App) onWatcherEvent(ctx context.Context, event types.Event) error {
 	}
 }
 
+// reconcileIncidents resolves open incidents whose access requests are no
+// longer pending, e.g. requests denied or expired while the plugin was down.
+// Plugin-created incidents are found through their correlation ID, which
+// records the access request ID, so only incidents created by a plugin version
+// that sets it are reconciled.
+func (a *App) reconcileIncidents(ctx context.Context) error {
+	logger.Get(ctx).Debug("Reconciling open incidents with access request state")
+
+	incidents, err := a.serviceNow.ListOpenIncidents(ctx, reconcileIncidentsLimit)
+	if err != nil {
+		return trace.Wrap(err)
+	}
+
+	var errors []error
+	for _, incident := range incidents {
+		reqID := incident.CorrelationID
+		ctx, log := logger.WithFields(ctx, logger.Fields{
+			"request_id":             reqID,
+			"servicenow_incident_id": incident.IncidentID,
+		})
+
+		reqs, err := a.teleport.GetAccessRequests(ctx, types.AccessRequestFilter{ID: reqID})
+		if err != nil {
+			errors = append(errors, trace.Wrap(err))
+			continue
+		}
+
+		switch {
+		case len(reqs) == 0:
+			// The request is gone and so is its plugin data, resolve the
+			// incident directly.
+			log.Info("Resolving the incident: access request no longer exists")
+			if err := a.serviceNow.ResolveIncident(ctx, incident.IncidentID, Resolution{State: ResolutionStateResolved}); err != nil {
+				errors = append(errors, trace.Wrap(err))
+			}
+		case reqs[0].GetState().IsResolved():
+			log.Info("Resolving the incident: access request is already resolved")
+			if err := a.onResolvedRequest(ctx, reqs[0]); err != nil {
+				errors = append(errors, trace.Wrap(err))
+			}
+		default:
+			log.Debug("Skipping the incident: access request is still pending")
+		}
+	}
+	return trace.NewAggregate(errors...)
+}
+
 func (a *App) onPendingRequest(ctx context.Context, req types.AccessRequest) error {
 	if len(req.GetSystemAnnotations()) == 0 {
 		logger.Get(ctx).Debug("Cannot proceed further. Request is missing any annotations")
@@ -250,6 +308,10 @@ func (a *App) onPendingRequest(ctx context.Context, req types.AccessRequest) err
 }
 
 func (a *App) onResolvedRequest(ctx context.Context, req types.AccessRequest) error {
+	if a.serviceNow.BatchUpdates {
+		return trace.Wrap(a.onResolvedRequestBatch(ctx, req))
+	}
+
 	var notifyErr error
 	if err := a.postReviewNotes(ctx, req.GetName(), req.GetReviews()); err != nil {
 		notifyErr = trace.Wrap(err)
@@ -262,6 +324,9 @@ func (a *App) onResolvedRequest(ctx context.Context, req types.AccessRequest) er
 	switch req.GetState() {
 	case types.RequestState_APPROVED:
 		state = ResolutionStateResolved
+		if err := a.postResolvedAccessNote(ctx, req); err != nil {
+			notifyErr = trace.NewAggregate(notifyErr, trace.Wrap(err))
+		}
 	case types.RequestState_DENIED:
 		state = ResolutionStateClosed
 	default:
@@ -269,12 +334,55 @@ func (a *App) onResolvedRequest(ctx context.Context, req types.AccessRequest) er
 	}
 	resolution.State = state
 
-	err := trace.Wrap(a.resolveIncident(ctx, req.GetName(), resolution))
+	err := trace.Wrap(a.resolveIncident(ctx, req.GetName(), resolution, nil))
+	return trace.NewAggregate(notifyErr, err)
+}
+
+// onResolvedRequestBatch composes the outstanding review notes, the resolved
+// access note and the resolution update into a single batch call instead of
+// posting them as separate updates, so the record reaches its final state
+// atomically. Notes that fail to render are reported but do not hold back the
+// resolution.
+func (a *App) onResolvedRequestBatch(ctx context.Context, req types.AccessRequest) error {
+	var notifyErr error
+	var notes []string
+
+	_, reviews, err := a.newReviewsToNotify(ctx, req.GetName(), req.GetReviews())
+	if err != nil {
+		notifyErr = trace.Wrap(err)
+	}
+	for _, review := range reviews {
+		note, err := a.serviceNow.BuildReviewNote(review)
+		if err != nil {
+			notifyErr = trace.NewAggregate(notifyErr, trace.Wrap(err))
+			continue
+		}
+		notes = append(notes, note)
+	}
+
+	resolution := Resolution{Reason: req.GetResolveReason()}
+
+	switch req.GetState() {
+	case types.RequestState_APPROVED:
+		resolution.State = ResolutionStateResolved
+		note, err := a.buildResolvedAccessNote(ctx, req)
+		if err != nil {
+			notifyErr = trace.NewAggregate(notifyErr, trace.Wrap(err))
+		} else if note != "" {
+			notes = append(notes, note)
+		}
+	case types.RequestState_DENIED:
+		resolution.State = ResolutionStateClosed
+	default:
+		return trace.BadParameter("onResolvedRequest called with non resolved request")
+	}
+
+	err = trace.Wrap(a.resolveIncident(ctx, req.GetName(), resolution, notes))
 	return trace.NewAggregate(notifyErr, err)
 }
 
 func (a *App) onDeletedRequest(ctx context.Context, reqID string) error {
-	return a.resolveIncident(ctx, reqID, Resolution{State: ResolutionStateResolved})
+	return a.resolveIncident(ctx, reqID, Resolution{State: ResolutionStateResolved}, nil)
 }
 
 func (a *App) getNotifyServiceNames(req types.AccessRequest) ([]string, error) {
@@ -363,8 +471,11 @@ func (a *App) createIncident(ctx context.Context, serviceID, reqID string, reqDa
 	return trace.Wrap(err)
 }
 
-// postReviewNotes posts incident notes about new reviews appeared for request.
-func (a *App) postReviewNotes(ctx context.Context, reqID string, reqReviews []types.AccessReview) error {
+// newReviewsToNotify increases the review counter in plugin data and returns
+// the incident ID along with the reviews that have not been turned into notes
+// yet. The incident ID is empty when plugin data is missing or all reviews
+// were already handled.
+func (a *App) newReviewsToNotify(ctx context.Context, reqID string, reqReviews []types.AccessReview) (string, []types.AccessReview, error) {
 	var oldCount int
 	var data ServiceNowData
 
@@ -387,28 +498,85 @@ func (a *App) postReviewNotes(ctx context.Context, reqID string, reqReviews []ty
 		return pluginData, true
 	})
 	if err != nil {
-		return trace.Wrap(err)
+		return "", nil, trace.Wrap(err)
 	}
 	if !ok {
 		logger.Get(ctx).Debug("Failed to post the note: plugin data is missing")
-		return nil
+		return "", nil, nil
 	}
-	ctx, _ = logger.WithField(ctx, "servicenow_incident_id", data.IncidentID)
+	return data.IncidentID, reqReviews[oldCount:], nil
+}
 
-	slice := reqReviews[oldCount:]
-	if len(slice) == 0 {
+// postReviewNotes posts incident notes about new reviews appeared for request.
+func (a *App) postReviewNotes(ctx context.Context, reqID string, reqReviews []types.AccessReview) error {
+	incidentID, reviews, err := a.newReviewsToNotify(ctx, reqID, reqReviews)
+	if err != nil {
+		return trace.Wrap(err)
+	}
+	if incidentID == "" || len(reviews) == 0 {
 		return nil
 	}
+	ctx, _ = logger.WithField(ctx, "servicenow_incident_id", incidentID)
 
-	errors := make([]error, 0, len(slice))
-	for _, review := range slice {
-		if err := a.serviceNow.PostReviewNote(ctx, data.IncidentID, review); err != nil {
+	errors := make([]error, 0, len(reviews))
+	for _, review := range reviews {
+		if err := a.serviceNow.PostReviewNote(ctx, incidentID, review); err != nil {
 			errors = append(errors, err)
 		}
 	}
 	return trace.NewAggregate(errors...)
 }
 
+// postResolvedAccessNote posts a note listing the roles and resources that were
+// actually granted versus those originally requested, which may differ when a
+// reviewer approves a subset of the requested access.
+func (a *App) postResolvedAccessNote(ctx context.Context, req types.AccessRequest) error {
+	data, err := a.getPluginData(ctx, req.GetName())
+	if err != nil {
+		if trace.IsNotFound(err) {
+			logger.Get(ctx).Debug("Failed to post the resolved access note: plugin data is missing")
+			return nil
+		}
+		return trace.Wrap(err)
+	}
+	if data.IncidentID == "" {
+		return nil
+	}
+
+	grantedResources := make([]string, 0, len(req.GetRequestedResourceIDs()))
+	for _, resourceID := range req.GetRequestedResourceIDs() {
+		grantedResources = append(grantedResources, types.ResourceIDToString(resourceID))
+	}
+
+	ctx, _ = logger.WithField(ctx, "servicenow_incident_id", data.IncidentID)
+	return trace.Wrap(a.serviceNow.PostResolvedAccessNote(ctx, data.IncidentID, data.RequestData.Roles, req.GetRoles(), grantedResources))
+}
+
+// buildResolvedAccessNote renders the resolved access note without posting
+// it, for delivery as part of a batch update. The note is empty when plugin
+// data is missing.
+func (a *App) buildResolvedAccessNote(ctx context.Context, req types.AccessRequest) (string, error) {
+	data, err := a.getPluginData(ctx, req.GetName())
+	if err != nil {
+		if trace.IsNotFound(err) {
+			logger.Get(ctx).Debug("Skipping the resolved access note: plugin data is missing")
+			return "", nil
+		}
+		return "", trace.Wrap(err)
+	}
+	if data.IncidentID == "" {
+		return "", nil
+	}
+
+	grantedResources := make([]string, 0, len(req.GetRequestedResourceIDs()))
+	for _, resourceID := range req.GetRequestedResourceIDs() {
+		grantedResources = append(grantedResources, types.ResourceIDToString(resourceID))
+	}
+
+	note, err := a.serviceNow.BuildResolvedAccessNote(data.RequestData.Roles, req.GetRoles(), grantedResources)
+	return note, trace.Wrap(err)
+}
+
 // tryApproveRequest attempts to submit an approval if the requesting user is on-call in one of the services provided in request annotation.
 func (a *App) tryApproveRequest(ctx context.Context, req types.AccessRequest) error {
 	log := logger.Get(ctx)
@@ -462,10 +630,16 @@ func (a *App) getOnCallUsers(ctx context.Context, serviceNames []string) ([]stri
 }
 
 // resolveIncident resolves the notification incident created by plugin if the incident exists.
-func (a *App) resolveIncident(ctx context.Context, reqID string, resolution Resolution) error {
+// Plugin data and the ServiceNow incident cannot be updated atomically, so the resolution
+// is first recorded as pending delivery and the marker is only cleared once the incident
+// was successfully updated in ServiceNow. If the ServiceNow update fails, the marker stays
+// set and a later call for the same request retries the update instead of quitting early.
+// Any notes are delivered together with the resolution, in a single batch call
+// when batch updates are enabled.
+func (a *App) resolveIncident(ctx context.Context, reqID string, resolution Resolution, notes []string) error {
 	var incidentID string
 
-	// Save request resolution info in plugin data.
+	// Save request resolution info in plugin data and mark it as pending delivery to ServiceNow.
 	ok, err := a.modifyPluginData(ctx, reqID, func(existing *PluginData) (PluginData, bool) {
 		// If plugin data is empty or missing incidentID, we cannot do anything.
 		if existing == nil {
@@ -475,14 +649,19 @@ func (a *App) resolveIncident(ctx context.Context, reqID string, resolution Reso
 			return PluginData{}, false
 		}
 
-		// If state field is not empty then we already resolved the incident before. In this case we just quit.
-		if existing.RequestData.Resolution.State != "" {
+		// If state field is not empty then we already resolved the incident before.
+		// In this case we only proceed if the ServiceNow update is still pending delivery.
+		if existing.RequestData.Resolution.State != "" && !existing.ResolutionPending {
 			return PluginData{}, false
 		}
 
-		// Mark incident as resolved.
+		// Mark incident as resolved, keeping the originally recorded resolution on retries.
 		pluginData := *existing
-		pluginData.Resolution = resolution
+		if pluginData.Resolution.State == "" {
+			pluginData.Resolution = resolution
+		}
+		resolution = pluginData.Resolution
+		pluginData.ResolutionPending = true
 		return pluginData, true
 	})
 	if err != nil {
@@ -494,7 +673,20 @@ func (a *App) resolveIncident(ctx context.Context, reqID string, resolution Reso
 	}
 
 	ctx, log := logger.WithField(ctx, "servicenow_incident_id", incidentID)
-	if err := a.serviceNow.ResolveIncident(ctx, incidentID, resolution); err != nil {
+	if err := a.serviceNow.ResolveIncidentWithNotes(ctx, incidentID, resolution, notes); err != nil {
+		if isUnretryableError(err) {
+			// A definitive error like access denied or a config problem will
+			// not succeed on retry, so give up on delivering the update.
+			log.WithError(err).Error("Failed to resolve the incident in ServiceNow, the update will not be retried")
+			return trace.NewAggregate(trace.Wrap(err), a.clearResolutionPending(ctx, reqID))
+		}
+		// The resolution is already recorded as pending delivery in plugin data
+		// so the update will be retried on a later event for this request.
+		log.WithError(err).Error("Failed to resolve the incident in ServiceNow, the update will be retried")
+		return trace.Wrap(err)
+	}
+
+	if err := a.clearResolutionPending(ctx, reqID); err != nil {
 		return trace.Wrap(err)
 	}
 	log.Info("Successfully resolved the incident")
@@ -502,6 +694,27 @@ func (a *App) resolveIncident(ctx context.Context, reqID string, resolution Reso
 	return nil
 }
 
+// clearResolutionPending clears the pending delivery marker on the request's
+// plugin data.
+func (a *App) clearResolutionPending(ctx context.Context, reqID string) error {
+	_, err := a.modifyPluginData(ctx, reqID, func(existing *PluginData) (PluginData, bool) {
+		if existing == nil || !existing.ResolutionPending {
+			return PluginData{}, false
+		}
+		pluginData := *existing
+		pluginData.ResolutionPending = false
+		return pluginData, true
+	})
+	return trace.Wrap(err)
+}
+
+// isUnretryableError reports whether an error returned by the ServiceNow API
+// is definitive and not worth retrying. Everything else, including generic
+// server errors, is considered transient.
+func isUnretryableError(err error) bool {
+	return trace.IsAccessDenied(err) || trace.IsBadParameter(err)
+}
+
 // modifyPluginData performs a compare-and-swap update of access request's plugin data.
 // Callback function parameter is nil if plugin data hasn't been created yet.
 // Otherwise, callback function parameter is a pointer to current plugin data contents.
@@ -18,9 +18,13 @@ package servicenow
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
+	"path"
+	"strconv"
 	"strings"
 	"text/template"
 	"time"
@@ -28,6 +32,11 @@ import (
 	"github.com/aws/aws-sdk-go/aws/defaults"
 	"github.com/go-resty/resty/v2"
 	"github.com/gravitational/trace"
+	"github.com/jonboulle/clockwork"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	oteltrace "go.opentelemetry.io/otel/trace"
+	"golang.org/x/time/rate"
 
 	"github.com/gravitational/teleport/api/types"
 	"github.com/gravitational/teleport/integrations/access/common"
@@ -38,6 +47,10 @@ import (
 const (
 	// DateTimeFormat is the time format used by servicenow
 	DateTimeFormat = "2006-01-02 15:04:05"
+
+	// unknownClusterName is the fallback cluster name used in incident bodies
+	// when ClusterName is not configured.
+	unknownClusterName = "unknown"
 )
 
 // Client is a wrapper around resty.Client.
@@ -45,6 +58,20 @@ type Client struct {
 	ClientConfig
 
 	client *resty.Client
+	// recordType maps the create/review/resolve flow onto the configured
+	// ServiceNow table.
+	recordType recordType
+	// userCache caches email/username to sys_id lookups.
+	userCache *userSysIDCache
+	// statusCodeMapping is the effective status code to error kind mapping,
+	// the default mapping overlaid with any configured entries.
+	statusCodeMapping map[int]ErrorKind
+	// clock is used to compute the dedup window cutoff. A real clock outside
+	// of tests.
+	clock clockwork.Clock
+	// createLimiter paces CreateIncident calls. Nil when rate limiting is
+	// disabled.
+	createLimiter *rate.Limiter
 }
 
 // ClientConfig is the config for the servicenow client.
@@ -60,16 +87,86 @@ type ClientConfig struct {
 	// ClusterName is the name of the Teleport cluster.
 	ClusterName string
 
+	// LinkBasePath is an optional path prefix prepended to the access-request
+	// link included in incident bodies, for deployments fronting Teleport with
+	// a reverse proxy under a path prefix such as "/teleport". Links point
+	// directly at /web/requests/<id> when unset.
+	LinkBasePath string
+
 	// Username is the username used by the client for basic auth.
 	Username string
 	// APIToken is the token used for basic auth.
 	APIToken string
 	// CloseCode is the ServiceNow close code that incidents will be closed with.
 	CloseCode string
+	// ResolutionComment mirrors resolution notes into the customer-visible
+	// comments field in addition to close notes. Close notes are often only
+	// visible to fulfillers, so this lets the requesting user see why their
+	// access request was granted or denied.
+	ResolutionComment bool
 
 	// StatusSink receives any status updates from the plugin for
 	// further processing. Status updates will be ignored if not set.
 	StatusSink common.StatusSink
+
+	// RecordType is the ServiceNow record type created for access requests,
+	// one of "incident", "change_request" or "sc_req_item". Defaults to
+	// "incident".
+	RecordType string
+
+	// CatalogItemID is the sys_id of the ServiceNow catalog item request items
+	// are created for. Required when RecordType is "sc_req_item".
+	CatalogItemID string
+
+	// UserCacheTTL is how long resolved user sys_ids are cached before being
+	// looked up again. Defaults to 10 minutes.
+	UserCacheTTL time.Duration
+
+	// DedupWindow is how long an open incident created for the same access
+	// request is reused by CreateIncident instead of creating a duplicate.
+	// Incidents older than the window are not reused even if still open.
+	// Deduplication is disabled when zero.
+	DedupWindow time.Duration
+
+	// BatchUpdates groups the resolution update and any final notes into a
+	// single Batch API request so the record reaches its final state
+	// atomically. Instances without the Batch API fall back to sequential
+	// update calls.
+	BatchUpdates bool
+
+	// CMDBCIMapping maps Teleport resource names to the names of CMDB
+	// configuration items. When a requested resource has a mapping, the
+	// created incident is linked to the matching CI via its cmdb_ci field.
+	// Resources without a mapping or without a matching CI are skipped.
+	CMDBCIMapping map[string]string
+
+	// AssignFromCMDB derives the incident assignment group from the support
+	// group of the linked configuration item, routing the incident to the team
+	// that owns the resource.
+	AssignFromCMDB bool
+
+	// CreateIncidentRate is the sustained rate, in incidents per second, at
+	// which CreateIncident calls are allowed to reach the API. Calls above
+	// the rate block until the limiter permits them, respecting the context,
+	// instead of failing, smoothing out access-request storms. Rate limiting
+	// is disabled when zero.
+	CreateIncidentRate float64
+	// CreateIncidentBurst is the number of CreateIncident calls allowed to
+	// proceed immediately before the rate applies. Defaults to 1 when
+	// CreateIncidentRate is set.
+	CreateIncidentBurst int
+
+	// StatusCodeMapping maps HTTP status codes returned by the ServiceNow API
+	// to trace error kinds, overriding the default mapping of 403 to access
+	// denied and 408 to connection problem. This lets deployments teach the
+	// client e.g. that 409 is retryable or that 401 means an expired token.
+	StatusCodeMapping map[int]ErrorKind
+
+	// RequestMiddleware is an optional list of resty request middlewares to
+	// run before each outgoing request, e.g. for custom headers, request
+	// signing or tenant routing. Middlewares run in the given order, after the
+	// built-in client setup (base URL, content-type headers and basic auth).
+	RequestMiddleware []resty.RequestMiddleware
 }
 
 // NewClient creates a new Servicenow client for managing incidents.
@@ -90,92 +187,433 @@ func NewClient(conf ClientConfig) (*Client, error) {
 		apiURL.Scheme = "https"
 	}
 
+	recordType, err := newRecordType(conf)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
 	client.SetBaseURL(conf.APIEndpoint).
 		SetHeader("Content-Type", "application/json").
 		SetHeader("Accept", "application/json").
 		SetBasicAuth(conf.Username, conf.APIToken)
+	client.OnBeforeRequest(injectTraceContext)
+	for _, middleware := range conf.RequestMiddleware {
+		client.OnBeforeRequest(middleware)
+	}
+	statusCodeMapping := make(map[int]ErrorKind, len(defaultStatusCodeMapping)+len(conf.StatusCodeMapping))
+	for statusCode, kind := range defaultStatusCodeMapping {
+		statusCodeMapping[statusCode] = kind
+	}
+	for statusCode, kind := range conf.StatusCodeMapping {
+		statusCodeMapping[statusCode] = kind
+	}
+
+	var createLimiter *rate.Limiter
+	if conf.CreateIncidentRate > 0 {
+		createLimiter = rate.NewLimiter(rate.Limit(conf.CreateIncidentRate), conf.CreateIncidentBurst)
+	}
+
 	return &Client{
-		client:       client,
-		ClientConfig: conf,
+		client:            client,
+		ClientConfig:      conf,
+		recordType:        recordType,
+		userCache:         newUserSysIDCache(conf.UserCacheTTL, clockwork.NewRealClock()),
+		statusCodeMapping: statusCodeMapping,
+		clock:             clockwork.NewRealClock(),
+		createLimiter:     createLimiter,
 	}, nil
 }
 
-func (conf ClientConfig) checkAndSetDefaults() error {
+func (conf *ClientConfig) checkAndSetDefaults() error {
 	if conf.APIEndpoint == "" {
 		return trace.BadParameter("missing required field: APIEndpoint")
 	}
+	if conf.WebProxyURL == nil {
+		logger.Standard().Warn("WebProxyURL is not set, incidents will not include links to review the access request")
+	}
+	if conf.ClusterName == "" {
+		logger.Standard().Warnf("ClusterName is not set, incidents will refer to the cluster as %q", unknownClusterName)
+		conf.ClusterName = unknownClusterName
+	}
+	if conf.LinkBasePath != "" {
+		if strings.Contains(conf.LinkBasePath, "//") {
+			return trace.BadParameter("LinkBasePath must not contain a double slash")
+		}
+		conf.LinkBasePath = "/" + strings.Trim(conf.LinkBasePath, "/")
+	}
+	for statusCode, kind := range conf.StatusCodeMapping {
+		switch kind {
+		case ErrorKindAccessDenied, ErrorKindConnectionProblem, ErrorKindBadParameter, ErrorKindLimitExceeded, ErrorKindGeneric:
+		default:
+			return trace.BadParameter("unsupported error kind %q for status code %d", kind, statusCode)
+		}
+	}
+	if conf.CreateIncidentRate < 0 {
+		return trace.BadParameter("CreateIncidentRate must not be negative")
+	}
+	if conf.CreateIncidentBurst < 0 {
+		return trace.BadParameter("CreateIncidentBurst must not be negative")
+	}
+	if conf.CreateIncidentRate > 0 && conf.CreateIncidentBurst == 0 {
+		conf.CreateIncidentBurst = 1
+	}
 	return nil
 }
 
-func errWrapper(statusCode int, body string) error {
-	switch statusCode {
-	case http.StatusForbidden:
+// injectTraceContext injects the OpenTelemetry trace context of the request
+// into the outgoing headers, so ServiceNow API calls made within a traced
+// operation stay part of the distributed trace. It is a no-op when no span is
+// present in the request context.
+func injectTraceContext(_ *resty.Client, req *resty.Request) error {
+	ctx := req.Context()
+	if !oteltrace.SpanContextFromContext(ctx).IsValid() {
+		return nil
+	}
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+	return nil
+}
+
+// ErrorKind is the trace error kind an HTTP status code returned by the
+// ServiceNow API maps to.
+type ErrorKind string
+
+const (
+	// ErrorKindAccessDenied maps the status code to trace.AccessDenied.
+	ErrorKindAccessDenied ErrorKind = "access_denied"
+	// ErrorKindConnectionProblem maps the status code to
+	// trace.ConnectionProblem, which is treated as retryable.
+	ErrorKindConnectionProblem ErrorKind = "connection_problem"
+	// ErrorKindBadParameter maps the status code to trace.BadParameter.
+	ErrorKindBadParameter ErrorKind = "bad_parameter"
+	// ErrorKindLimitExceeded maps the status code to trace.LimitExceeded,
+	// which is treated as retryable.
+	ErrorKindLimitExceeded ErrorKind = "limit_exceeded"
+	// ErrorKindGeneric maps the status code to a generic trace error.
+	ErrorKindGeneric ErrorKind = "generic"
+)
+
+// defaultStatusCodeMapping preserves the historical status code handling.
+var defaultStatusCodeMapping = map[int]ErrorKind{
+	http.StatusForbidden:      ErrorKindAccessDenied,
+	http.StatusRequestTimeout: ErrorKindConnectionProblem,
+}
+
+func (snc *Client) errWrapper(statusCode int, body string) error {
+	kind, ok := snc.statusCodeMapping[statusCode]
+	if !ok {
+		kind = ErrorKindGeneric
+	}
+	switch kind {
+	case ErrorKindAccessDenied:
 		return trace.AccessDenied("servicenow API access denied: status code %v: %q", statusCode, body)
-	case http.StatusRequestTimeout:
+	case ErrorKindConnectionProblem:
 		return trace.ConnectionProblem(nil, "request to servicenow API failed: status code %v: %q", statusCode, body)
+	case ErrorKindBadParameter:
+		return trace.BadParameter("request to servicenow API failed: status code %v: %q", statusCode, body)
+	case ErrorKindLimitExceeded:
+		return trace.LimitExceeded("request to servicenow API throttled: status code %v: %q", statusCode, body)
 	}
 	return trace.Errorf("request to servicenow API failed: status code %d: %q", statusCode, body)
 }
 
-// CreateIncident creates an servicenow incident.
+// CreateIncident creates an servicenow incident. When a dedup window is
+// configured, an open incident created for the same access request within the
+// window is reused instead of creating a duplicate.
 func (snc *Client) CreateIncident(ctx context.Context, reqID string, reqData RequestData) (Incident, error) {
+	if snc.createLimiter != nil {
+		if err := snc.createLimiter.Wait(ctx); err != nil {
+			return Incident{}, trace.Wrap(err)
+		}
+	}
+
+	if snc.DedupWindow > 0 {
+		existing, err := snc.findRecentIncident(ctx, reqID)
+		if err != nil {
+			return Incident{}, trace.Wrap(err)
+		}
+		if existing.IncidentID != "" {
+			logger.Get(ctx).Debugf("Reusing incident %v created within the dedup window", existing.IncidentID)
+			return existing, nil
+		}
+	}
+
 	bodyDetails, err := snc.buildIncidentBody(snc.WebProxyURL, reqID, reqData)
 	if err != nil {
 		return Incident{}, trace.Wrap(err)
 	}
 
 	body := Incident{
+		CorrelationID:    reqID,
 		ShortDescription: fmt.Sprintf("Teleport access request from user %s", reqData.User),
 		Description:      bodyDetails,
-		Caller:           reqData.User,
+		Caller:           snc.resolveUser(ctx, reqData.User),
+	}
+
+	if len(snc.CMDBCIMapping) > 0 {
+		snc.applyCMDBLinkage(ctx, &body, reqData.Resources)
 	}
 
 	if len(reqData.SuggestedReviewers) != 0 {
 		// Only one assignee per incident allowed so just grab the first.
-		body.AssignedTo = reqData.SuggestedReviewers[0]
+		body.AssignedTo = snc.resolveUser(ctx, reqData.SuggestedReviewers[0])
+		// The remaining suggested reviewers go on the watch list.
+		if rest := reqData.SuggestedReviewers[1:]; len(rest) > 0 {
+			watchers := make([]string, 0, len(rest))
+			for _, reviewer := range rest {
+				watchers = append(watchers, snc.resolveUser(ctx, reviewer))
+			}
+			body.WatchList = strings.Join(watchers, ",")
+		}
 	}
 
 	var result incidentResult
 	resp, err := snc.client.NewRequest().
 		SetContext(ctx).
-		SetBody(body).
+		SetBody(snc.recordType.createBody(body)).
 		SetResult(&result).
-		Post("/api/now/v1/table/incident")
+		Post(snc.recordType.tablePath())
 	if err != nil {
 		return Incident{}, trace.Wrap(err)
 	}
 	defer resp.RawResponse.Body.Close()
 	if resp.IsError() {
-		return Incident{}, errWrapper(resp.StatusCode(), string(resp.Body()))
+		return Incident{}, snc.errWrapper(resp.StatusCode(), string(resp.Body()))
 	}
 
 	return Incident{IncidentID: result.Result.IncidentID}, nil
 }
 
+// applyCMDBLinkage links the incident body to the CMDB configuration item
+// matching the first requested resource with a configured mapping, and
+// optionally derives the assignment group from the CI's support group.
+// Lookup failures and resources without a matching CI are skipped so CMDB
+// linkage never blocks incident creation.
+func (snc *Client) applyCMDBLinkage(ctx context.Context, body *Incident, resources []string) {
+	log := logger.Get(ctx)
+	for _, resource := range resources {
+		ciName, ok := snc.CMDBCIMapping[resource]
+		if !ok {
+			continue
+		}
+		ciSysID, supportGroup, err := snc.lookupCI(ctx, ciName)
+		if err != nil {
+			if !trace.IsNotFound(err) {
+				log.WithError(err).Warningf("Failed to look up configuration item %q", ciName)
+			}
+			continue
+		}
+		body.CmdbCI = ciSysID
+		if snc.AssignFromCMDB && supportGroup != "" {
+			body.AssignmentGroup = supportGroup
+		}
+		return
+	}
+}
+
+// lookupCI returns the sys_id and support group sys_id of the CMDB
+// configuration item with the given name.
+func (snc *Client) lookupCI(ctx context.Context, name string) (string, string, error) {
+	var result ciResult
+	resp, err := snc.client.NewRequest().
+		SetContext(ctx).
+		SetQueryParams(map[string]string{
+			"sysparm_query":  fmt.Sprintf("name=%s", name),
+			"sysparm_fields": "sys_id,support_group",
+			"sysparm_limit":  "1",
+		}).
+		SetResult(&result).
+		Get("/api/now/table/cmdb_ci")
+	if err != nil {
+		return "", "", trace.Wrap(err)
+	}
+	defer resp.RawResponse.Body.Close()
+	if resp.IsError() {
+		return "", "", snc.errWrapper(resp.StatusCode(), string(resp.Body()))
+	}
+	if len(result.Result) == 0 {
+		return "", "", trace.NotFound("no configuration item found for name %q", name)
+	}
+	return result.Result[0].SysID, result.Result[0].SupportGroup.Value, nil
+}
+
 // PostReviewNote posts a note once a new request review appears.
 func (snc *Client) PostReviewNote(ctx context.Context, incidentID string, review types.AccessReview) error {
 	note, err := snc.buildReviewNoteBody(review)
 	if err != nil {
 		return trace.Wrap(err)
 	}
-	body := Incident{
-		WorkNotes: note,
-	}
 	resp, err := snc.client.NewRequest().
 		SetContext(ctx).
-		SetBody(body).
+		SetBody(snc.recordType.noteBody(note)).
 		SetPathParams(map[string]string{"sys_id": incidentID}).
-		Patch("/api/now/v1/table/incident/{sys_id}")
+		Patch(snc.recordType.recordPath())
 	if err != nil {
 		return trace.Wrap(err)
 	}
 	defer resp.RawResponse.Body.Close()
 	if resp.IsError() {
-		return errWrapper(resp.StatusCode(), string(resp.Body()))
+		return snc.errWrapper(resp.StatusCode(), string(resp.Body()))
 	}
 	return nil
 }
 
+// PostResolvedAccessNote posts a note listing which roles and resources were
+// actually granted versus those originally requested, so the incident records
+// exactly what access resulted from an approval, not just that it happened.
+func (snc *Client) PostResolvedAccessNote(ctx context.Context, incidentID string, requestedRoles, grantedRoles, grantedResources []string) error {
+	note, err := snc.BuildResolvedAccessNote(requestedRoles, grantedRoles, grantedResources)
+	if err != nil {
+		return trace.Wrap(err)
+	}
+	return trace.Wrap(snc.postNote(ctx, incidentID, note))
+}
+
+// BuildResolvedAccessNote renders the note listing the access that was
+// granted versus requested, for callers that deliver the note as part of a
+// batch update instead of through PostResolvedAccessNote.
+func (snc *Client) BuildResolvedAccessNote(requestedRoles, grantedRoles, grantedResources []string) (string, error) {
+	var builder strings.Builder
+	err := resolvedAccessNoteTemplate.Execute(&builder, struct {
+		RequestedRoles   []string
+		GrantedRoles     []string
+		GrantedResources []string
+	}{
+		RequestedRoles:   requestedRoles,
+		GrantedRoles:     grantedRoles,
+		GrantedResources: grantedResources,
+	})
+	if err != nil {
+		return "", trace.Wrap(err)
+	}
+	return builder.String(), nil
+}
+
+// BuildReviewNote renders the work note for a review, for callers that
+// deliver the note as part of a batch update instead of through
+// PostReviewNote.
+func (snc *Client) BuildReviewNote(review types.AccessReview) (string, error) {
+	note, err := snc.buildReviewNoteBody(review)
+	return note, trace.Wrap(err)
+}
+
+// maxAttachmentSize bounds image uploads client-side so oversized attachments
+// fail fast with a clear error instead of being rejected by the instance.
+const maxAttachmentSize = 5 << 20
+
+// AttachImageToNote uploads an image through the ServiceNow attachment API and
+// posts a work note embedding it, so screenshots or diagrams justifying an
+// access request are visible on the incident. It returns the embed reference,
+// which can be reused in further notes.
+func (snc *Client) AttachImageToNote(ctx context.Context, incidentID, name, contentType string, data []byte) (string, error) {
+	if len(data) == 0 {
+		return "", trace.BadParameter("attachment %q is empty", name)
+	}
+	if len(data) > maxAttachmentSize {
+		return "", trace.BadParameter("attachment %q is %d bytes, exceeding the %d byte limit", name, len(data), maxAttachmentSize)
+	}
+	if !strings.HasPrefix(contentType, "image/") {
+		return "", trace.BadParameter("unsupported attachment content type %q, only images can be embedded in notes", contentType)
+	}
+
+	var result attachmentResult
+	resp, err := snc.client.NewRequest().
+		SetContext(ctx).
+		SetHeader("Content-Type", contentType).
+		SetBody(data).
+		SetQueryParams(map[string]string{
+			"table_name":   snc.recordType.tableName(),
+			"table_sys_id": incidentID,
+			"file_name":    name,
+		}).
+		SetResult(&result).
+		Post("/api/now/attachment/file")
+	if err != nil {
+		return "", trace.Wrap(err)
+	}
+	defer resp.RawResponse.Body.Close()
+	if resp.IsError() {
+		return "", snc.errWrapper(resp.StatusCode(), string(resp.Body()))
+	}
+
+	reference := fmt.Sprintf(`[code]<img src="/sys_attachment.do?sys_id=%s"/>[/code]`, result.Result.SysID)
+
+	noteResp, err := snc.client.NewRequest().
+		SetContext(ctx).
+		SetBody(snc.recordType.noteBody(reference)).
+		SetPathParams(map[string]string{"sys_id": incidentID}).
+		Patch(snc.recordType.recordPath())
+	if err != nil {
+		return "", trace.Wrap(err)
+	}
+	defer noteResp.RawResponse.Body.Close()
+	if noteResp.IsError() {
+		return "", snc.errWrapper(noteResp.StatusCode(), string(noteResp.Body()))
+	}
+	return reference, nil
+}
+
+// findRecentIncident returns the open incident created for the given access
+// request within the dedup window, or an empty incident if there is none.
+func (snc *Client) findRecentIncident(ctx context.Context, reqID string) (Incident, error) {
+	cutoff := snc.clock.Now().UTC().Add(-snc.DedupWindow).Format(DateTimeFormat)
+	var result listIncidentsResult
+	resp, err := snc.client.NewRequest().
+		SetContext(ctx).
+		SetQueryParams(map[string]string{
+			"sysparm_query":  fmt.Sprintf("correlation_id=%s^active=true^sys_created_on>=%s", reqID, cutoff),
+			"sysparm_fields": "sys_id,correlation_id",
+			"sysparm_limit":  "1",
+		}).
+		SetResult(&result).
+		Get(snc.recordType.tablePath())
+	if err != nil {
+		return Incident{}, trace.Wrap(err)
+	}
+	defer resp.RawResponse.Body.Close()
+	if resp.IsError() {
+		return Incident{}, snc.errWrapper(resp.StatusCode(), string(resp.Body()))
+	}
+	if len(result.Result) == 0 {
+		return Incident{}, nil
+	}
+	return Incident{
+		IncidentID:    result.Result[0].IncidentID,
+		CorrelationID: result.Result[0].CorrelationID,
+	}, nil
+}
+
+// ListOpenIncidents returns up to limit open incidents created by this plugin.
+// Plugin-created incidents are identified by their correlation ID, which
+// records the Teleport access request ID at creation time.
+func (snc *Client) ListOpenIncidents(ctx context.Context, limit int) ([]Incident, error) {
+	var result listIncidentsResult
+	resp, err := snc.client.NewRequest().
+		SetContext(ctx).
+		SetQueryParams(map[string]string{
+			"sysparm_query":  "active=true^correlation_idISNOTEMPTY",
+			"sysparm_fields": "sys_id,correlation_id",
+			"sysparm_limit":  strconv.Itoa(limit),
+		}).
+		SetResult(&result).
+		Get(snc.recordType.tablePath())
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+	defer resp.RawResponse.Body.Close()
+	if resp.IsError() {
+		return nil, snc.errWrapper(resp.StatusCode(), string(resp.Body()))
+	}
+
+	incidents := make([]Incident, 0, len(result.Result))
+	for _, incident := range result.Result {
+		incidents = append(incidents, Incident{
+			IncidentID:    incident.IncidentID,
+			CorrelationID: incident.CorrelationID,
+		})
+	}
+	return incidents, nil
+}
+
 // ResolveIncident resolves an incident and posts a note with resolution details.
 func (snc *Client) ResolveIncident(ctx context.Context, incidentID string, resolution Resolution) error {
 	note, err := snc.buildResolutionNoteBody(resolution)
@@ -187,24 +625,163 @@ func (snc *Client) ResolveIncident(ctx context.Context, incidentID string, resol
 		IncidentState: resolution.State,
 		CloseNotes:    note,
 	}
+	if snc.ResolutionComment {
+		body.Comments = note
+	}
 	resp, err := snc.client.NewRequest().
 		SetContext(ctx).
-		SetBody(body).
+		SetBody(snc.recordType.resolveBody(body)).
 		SetPathParams(map[string]string{"sys_id": incidentID}).
-		Patch("/api/now/v1/table/incident/{sys_id}")
+		Patch(snc.recordType.recordPath())
 	if err != nil {
 		return trace.Wrap(err)
 	}
 	defer resp.RawResponse.Body.Close()
 	if resp.IsError() {
-		return errWrapper(resp.StatusCode(), string(resp.Body()))
+		return snc.errWrapper(resp.StatusCode(), string(resp.Body()))
+	}
+	return nil
+}
+
+// batchPath is the ServiceNow Batch API endpoint.
+const batchPath = "/api/now/v1/batch"
+
+// ResolveIncidentWithNotes resolves an incident and posts the given work notes
+// in a single Batch API request, so the record reaches its final state
+// atomically instead of through several round trips that can partially fail.
+// When batch updates are disabled or the instance does not support the Batch
+// API, the updates are applied sequentially instead.
+func (snc *Client) ResolveIncidentWithNotes(ctx context.Context, incidentID string, resolution Resolution, notes []string) error {
+	if !snc.BatchUpdates {
+		return trace.Wrap(snc.resolveIncidentSequentially(ctx, incidentID, resolution, notes))
+	}
+	err := snc.resolveIncidentBatch(ctx, incidentID, resolution, notes)
+	if trace.IsNotImplemented(err) {
+		logger.Get(ctx).Debug("Batch API is not supported by the ServiceNow instance, falling back to sequential updates")
+		return trace.Wrap(snc.resolveIncidentSequentially(ctx, incidentID, resolution, notes))
+	}
+	return trace.Wrap(err)
+}
+
+// resolveIncidentBatch composes the resolution update and the given notes into
+// a single Batch API request.
+func (snc *Client) resolveIncidentBatch(ctx context.Context, incidentID string, resolution Resolution, notes []string) error {
+	note, err := snc.buildResolutionNoteBody(resolution)
+	if err != nil {
+		return trace.Wrap(err)
+	}
+	body := Incident{
+		CloseCode:     snc.CloseCode,
+		IncidentState: resolution.State,
+		CloseNotes:    note,
+	}
+	if snc.ResolutionComment {
+		body.Comments = note
+	}
+
+	recordURL := strings.Replace(snc.recordType.recordPath(), "{sys_id}", incidentID, 1)
+	restRequests := make([]batchRestRequest, 0, len(notes)+1)
+	addRequest := func(payload any) error {
+		encoded, err := json.Marshal(payload)
+		if err != nil {
+			return trace.Wrap(err)
+		}
+		restRequests = append(restRequests, batchRestRequest{
+			ID:      strconv.Itoa(len(restRequests) + 1),
+			Method:  http.MethodPatch,
+			URL:     recordURL,
+			Headers: []batchHeader{{Name: "Content-Type", Value: "application/json"}},
+			Body:    base64.StdEncoding.EncodeToString(encoded),
+		})
+		return nil
+	}
+	if err := addRequest(snc.recordType.resolveBody(body)); err != nil {
+		return trace.Wrap(err)
+	}
+	for _, note := range notes {
+		if err := addRequest(snc.recordType.noteBody(note)); err != nil {
+			return trace.Wrap(err)
+		}
+	}
+
+	var result batchResult
+	resp, err := snc.client.NewRequest().
+		SetContext(ctx).
+		SetBody(batchRequest{
+			BatchRequestID: incidentID,
+			RestRequests:   restRequests,
+		}).
+		SetResult(&result).
+		Post(batchPath)
+	if err != nil {
+		return trace.Wrap(err)
+	}
+	defer resp.RawResponse.Body.Close()
+	if resp.StatusCode() == http.StatusNotFound || resp.StatusCode() == http.StatusMethodNotAllowed {
+		return trace.NotImplemented("batch API is not supported: status code %v", resp.StatusCode())
+	}
+	if resp.IsError() {
+		return snc.errWrapper(resp.StatusCode(), string(resp.Body()))
+	}
+
+	if len(result.UnservicedRequests) != 0 {
+		return trace.Errorf("batch request left %d of %d requests unserviced", len(result.UnservicedRequests), len(restRequests))
+	}
+	var errors []error
+	for _, serviced := range result.ServicedRequests {
+		if serviced.StatusCode >= 400 {
+			// Serviced request bodies come back base64-encoded.
+			body := serviced.Body
+			if decoded, err := base64.StdEncoding.DecodeString(body); err == nil {
+				body = string(decoded)
+			}
+			errors = append(errors, snc.errWrapper(serviced.StatusCode, body))
+		}
+	}
+	return trace.NewAggregate(errors...)
+}
+
+// resolveIncidentSequentially applies the resolution update and the given
+// notes through individual update calls.
+func (snc *Client) resolveIncidentSequentially(ctx context.Context, incidentID string, resolution Resolution, notes []string) error {
+	if err := snc.ResolveIncident(ctx, incidentID, resolution); err != nil {
+		return trace.Wrap(err)
+	}
+	for _, note := range notes {
+		if err := snc.postNote(ctx, incidentID, note); err != nil {
+			return trace.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// postNote posts a work note to the given incident.
+func (snc *Client) postNote(ctx context.Context, incidentID, note string) error {
+	resp, err := snc.client.NewRequest().
+		SetContext(ctx).
+		SetBody(snc.recordType.noteBody(note)).
+		SetPathParams(map[string]string{"sys_id": incidentID}).
+		Patch(snc.recordType.recordPath())
+	if err != nil {
+		return trace.Wrap(err)
+	}
+	defer resp.RawResponse.Body.Close()
+	if resp.IsError() {
+		return snc.errWrapper(resp.StatusCode(), string(resp.Body()))
 	}
 	return nil
 }
 
 // GetOnCall returns the current users on-call for the given rota ID.
 func (snc *Client) GetOnCall(ctx context.Context, rotaID string) ([]string, error) {
-	formattedTime := time.Now().Format(DateTimeFormat)
+	return snc.GetOnCallAt(ctx, rotaID, snc.clock.Now())
+}
+
+// GetOnCallAt returns the users on-call for the given rota ID at the given
+// time, e.g. when the access window of a request starts, so incidents can be
+// assigned to the person who will actually be on call then.
+func (snc *Client) GetOnCallAt(ctx context.Context, rotaID string, at time.Time) ([]string, error) {
+	formattedTime := at.Format(DateTimeFormat)
 	var result onCallResult
 	resp, err := snc.client.NewRequest().
 		SetContext(ctx).
@@ -219,7 +796,7 @@ func (snc *Client) GetOnCall(ctx context.Context, rotaID string) ([]string, erro
 	}
 	defer resp.RawResponse.Body.Close()
 	if resp.IsError() {
-		return nil, errWrapper(resp.StatusCode(), string(resp.Body()))
+		return nil, snc.errWrapper(resp.StatusCode(), string(resp.Body()))
 	}
 	if len(result.Result) == 0 {
 		return nil, trace.NotFound("no user found for given rota: %q", rotaID)
@@ -235,6 +812,48 @@ func (snc *Client) GetOnCall(ctx context.Context, rotaID string) ([]string, erro
 	return emails, nil
 }
 
+// GetIncidentSLA returns the status of each SLA attached to the given
+// incident, so callers can surface access reviews that are approaching
+// breach. Incidents with no SLAs attached return an empty slice.
+func (snc *Client) GetIncidentSLA(ctx context.Context, incidentID string) ([]SLAStatus, error) {
+	var result taskSLAResult
+	resp, err := snc.client.NewRequest().
+		SetContext(ctx).
+		SetQueryParams(map[string]string{
+			"sysparm_query":  "task=" + incidentID,
+			"sysparm_fields": "sla,percentage,has_breached,planned_end_time",
+		}).
+		SetResult(&result).
+		Get("/api/now/table/task_sla")
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+	defer resp.RawResponse.Body.Close()
+	if resp.IsError() {
+		return nil, snc.errWrapper(resp.StatusCode(), string(resp.Body()))
+	}
+
+	slas := make([]SLAStatus, 0, len(result.Result))
+	for _, sla := range result.Result {
+		status := SLAStatus{
+			SLAID:       sla.SLA.Value,
+			HasBreached: sla.HasBreached == "true",
+		}
+		if sla.Percentage != "" {
+			if status.Percentage, err = strconv.ParseFloat(sla.Percentage, 64); err != nil {
+				return nil, trace.Wrap(err, "parsing SLA percentage %q", sla.Percentage)
+			}
+		}
+		if sla.PlannedEndTime != "" {
+			if status.BreachTime, err = time.Parse(DateTimeFormat, sla.PlannedEndTime); err != nil {
+				return nil, trace.Wrap(err, "parsing SLA planned end time %q", sla.PlannedEndTime)
+			}
+		}
+		slas = append(slas, status)
+	}
+	return slas, nil
+}
+
 // CheckHealth pings servicenow to check if it is reachable.
 func (snc *Client) CheckHealth(ctx context.Context) error {
 	resp, err := snc.client.NewRequest().
@@ -265,11 +884,54 @@ func (snc *Client) CheckHealth(ctx context.Context) error {
 	}
 
 	if resp.IsError() {
-		return errWrapper(resp.StatusCode(), string(resp.Body()))
+		return snc.errWrapper(resp.StatusCode(), string(resp.Body()))
 	}
 	return nil
 }
 
+// resolveUser resolves an email address or username to a ServiceNow sys_id
+// through the client's cache. If the lookup fails, the raw value is used
+// instead and left for ServiceNow to resolve as a display value.
+func (snc *Client) resolveUser(ctx context.Context, emailOrUsername string) string {
+	if emailOrUsername == "" {
+		return ""
+	}
+	sysID, err := snc.userCache.resolve(ctx, emailOrUsername, func(ctx context.Context) (string, error) {
+		return snc.getUserSysID(ctx, emailOrUsername)
+	})
+	if err != nil {
+		logger.Get(ctx).WithError(err).Debugf("Failed to resolve %q to a ServiceNow sys_id", emailOrUsername)
+		return emailOrUsername
+	}
+	return sysID
+}
+
+// getUserSysID returns the sys_id of the servicenow user with the given email
+// address or username.
+func (snc *Client) getUserSysID(ctx context.Context, emailOrUsername string) (string, error) {
+	var result userSysIDResult
+	resp, err := snc.client.NewRequest().
+		SetContext(ctx).
+		SetQueryParams(map[string]string{
+			"sysparm_query":  fmt.Sprintf("email=%s^ORuser_name=%s", emailOrUsername, emailOrUsername),
+			"sysparm_fields": "sys_id",
+			"sysparm_limit":  "1",
+		}).
+		SetResult(&result).
+		Get("/api/now/table/sys_user")
+	if err != nil {
+		return "", trace.Wrap(err)
+	}
+	defer resp.RawResponse.Body.Close()
+	if resp.IsError() {
+		return "", snc.errWrapper(resp.StatusCode(), string(resp.Body()))
+	}
+	if len(result.Result) == 0 {
+		return "", trace.NotFound("no user found for %q", emailOrUsername)
+	}
+	return result.Result[0].SysID, nil
+}
+
 // GetUserEmail returns the email address for the given user ID
 func (snc *Client) GetUserEmail(ctx context.Context, userID string) (string, error) {
 	var result userResult
@@ -286,7 +948,7 @@ func (snc *Client) GetUserEmail(ctx context.Context, userID string) (string, err
 	}
 	defer resp.RawResponse.Body.Close()
 	if resp.IsError() {
-		return "", errWrapper(resp.StatusCode(), string(resp.Body()))
+		return "", snc.errWrapper(resp.StatusCode(), string(resp.Body()))
 	}
 	if len(result.Result) == 0 {
 		return "", trace.NotFound("no user found for given id")
@@ -319,13 +981,23 @@ Resolution: {{.ProposedState}}.
 		`Access request has been {{.Resolution}}
 {{if .ResolveReason}}Reason: {{.ResolveReason}}{{end}}`,
 	))
+	resolvedAccessNoteTemplate = template.Must(template.New("resolved access note").Parse(
+		`Access request has been approved with the following access:
+Requested roles: {{range $index, $element := .RequestedRoles}}{{if $index}}, {{end}}{{ . }}{{end}}.
+Granted roles: {{range $index, $element := .GrantedRoles}}{{if $index}}, {{end}}{{ . }}{{end}}.
+{{if .GrantedResources}}Granted resources: {{range $index, $element := .GrantedResources}}{{if $index}}, {{end}}{{ . }}{{end}}.{{end}}`,
+	))
 )
 
 func (snc *Client) buildIncidentBody(webProxyURL *url.URL, reqID string, reqData RequestData) (string, error) {
 	var requestLink string
 	if webProxyURL != nil {
 		reqURL := *webProxyURL
-		reqURL.Path = lib.BuildURLPath("web", "requests", reqID)
+		urlPath := lib.BuildURLPath("web", "requests", reqID)
+		if snc.LinkBasePath != "" {
+			urlPath = path.Join(snc.LinkBasePath, urlPath)
+		}
+		reqURL.Path = urlPath
 		requestLink = reqURL.String()
 	}
 
@@ -18,15 +18,24 @@ package servicenow
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/go-resty/resty/v2"
 	"github.com/gravitational/trace"
+	"github.com/jonboulle/clockwork"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	oteltrace "go.opentelemetry.io/otel/trace"
 
 	"github.com/gravitational/teleport/api/types"
 )
@@ -57,8 +66,9 @@ func TestCreateIncident(t *testing.T) {
 	assert.NoError(t, err)
 
 	expected := Incident{
+		CorrelationID:    "someRequestID",
 		ShortDescription: "Teleport access request from user someUser",
-		Description:      "Teleport user someUser submitted access request for roles role1, role2 on Teleport cluster .\nReason: someReason\n\n",
+		Description:      "Teleport user someUser submitted access request for roles role1, role2 on Teleport cluster unknown.\nReason: someReason\n\n",
 		Caller:           "someUser",
 	}
 	var got Incident
@@ -103,6 +113,37 @@ func TestPostReviewNote(t *testing.T) {
 	assert.Equal(t, expected, got)
 }
 
+func TestPostResolvedAccessNote(t *testing.T) {
+	recievedReq := ""
+	testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		bodyBytes, err := io.ReadAll(req.Body)
+		if err != nil {
+			t.Error(err)
+			res.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		recievedReq = string(bodyBytes)
+	}))
+	defer func() { testServer.Close() }()
+
+	c, err := NewClient(ClientConfig{
+		APIEndpoint: testServer.URL,
+	})
+	require.NoError(t, err)
+
+	err = c.PostResolvedAccessNote(context.Background(), "someIncidentID", []string{"role1", "role2"}, []string{"role1"}, nil)
+	assert.NoError(t, err)
+
+	expected := Incident{
+		WorkNotes: "Access request has been approved with the following access:\nRequested roles: role1, role2.\nGranted roles: role1.\n",
+	}
+	var got Incident
+	err = json.Unmarshal([]byte(recievedReq), &got)
+	assert.NoError(t, err)
+
+	assert.Equal(t, expected, got)
+}
+
 func TestResolveIncident(t *testing.T) {
 	recievedReq := ""
 	testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
@@ -141,6 +182,478 @@ func TestResolveIncident(t *testing.T) {
 
 }
 
+func TestResolveIncidentWithComment(t *testing.T) {
+	recievedReq := ""
+	testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		bodyBytes, err := io.ReadAll(req.Body)
+		if err != nil {
+			t.Error(err)
+			res.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		recievedReq = string(bodyBytes)
+	}))
+	defer func() { testServer.Close() }()
+
+	c, err := NewClient(ClientConfig{
+		APIEndpoint:       testServer.URL,
+		CloseCode:         "approved",
+		ResolutionComment: true,
+	})
+	require.NoError(t, err)
+
+	err = c.ResolveIncident(context.Background(), "someIncidentID", Resolution{
+		Reason: "someReason",
+		State:  "6",
+	})
+	assert.NoError(t, err)
+
+	expected := Incident{
+		CloseNotes:    "Access request has been approved\nReason: someReason",
+		Comments:      "Access request has been approved\nReason: someReason",
+		CloseCode:     "approved",
+		IncidentState: "6",
+	}
+	var got Incident
+	err = json.Unmarshal([]byte(recievedReq), &got)
+	assert.NoError(t, err)
+
+	assert.Equal(t, expected, got)
+}
+
+func TestCreateChangeRequest(t *testing.T) {
+	recievedReq := ""
+	recievedPath := ""
+	testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		bodyBytes, err := io.ReadAll(req.Body)
+		if err != nil {
+			t.Error(err)
+			res.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		recievedReq = string(bodyBytes)
+		recievedPath = req.URL.Path
+	}))
+	defer func() { testServer.Close() }()
+
+	c, err := NewClient(ClientConfig{
+		APIEndpoint: testServer.URL,
+		RecordType:  RecordTypeChangeRequest,
+	})
+	require.NoError(t, err)
+
+	_, err = c.CreateIncident(context.Background(), "someRequestID", RequestData{
+		User:          "someUser",
+		Roles:         []string{"role1", "role2"},
+		RequestReason: "someReason",
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "/api/now/table/change_request", recievedPath)
+
+	// The explicit false must reach the change_request table rather than be
+	// dropped by omitempty.
+	assert.Contains(t, recievedReq, `"cab_required":false`)
+
+	cabRequired := false
+	expected := ChangeRequest{
+		CorrelationID:    "someRequestID",
+		ShortDescription: "Teleport access request from user someUser",
+		Description:      "Teleport user someUser submitted access request for roles role1, role2 on Teleport cluster unknown.\nReason: someReason\n\n",
+		RequestedBy:      "someUser",
+		CabRequired:      &cabRequired,
+	}
+	var got ChangeRequest
+	err = json.Unmarshal([]byte(recievedReq), &got)
+	assert.NoError(t, err)
+
+	assert.Equal(t, expected, got)
+}
+
+func TestResolveChangeRequest(t *testing.T) {
+	recievedReq := ""
+	recievedPath := ""
+	testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		bodyBytes, err := io.ReadAll(req.Body)
+		if err != nil {
+			t.Error(err)
+			res.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		recievedReq = string(bodyBytes)
+		recievedPath = req.URL.Path
+	}))
+	defer func() { testServer.Close() }()
+
+	c, err := NewClient(ClientConfig{
+		APIEndpoint: testServer.URL,
+		CloseCode:   "successful",
+		RecordType:  RecordTypeChangeRequest,
+	})
+	require.NoError(t, err)
+
+	err = c.ResolveIncident(context.Background(), "someChangeRequestID", Resolution{
+		Reason: "someReason",
+		State:  ResolutionStateResolved,
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "/api/now/table/change_request/someChangeRequestID", recievedPath)
+
+	expected := ChangeRequest{
+		CloseNotes: "Access request has been successful\nReason: someReason",
+		CloseCode:  "successful",
+		State:      ChangeRequestStateClosed,
+	}
+	var got ChangeRequest
+	err = json.Unmarshal([]byte(recievedReq), &got)
+	assert.NoError(t, err)
+
+	assert.Equal(t, expected, got)
+}
+
+func TestCreateRequestItem(t *testing.T) {
+	recievedReq := ""
+	recievedPath := ""
+	testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		bodyBytes, err := io.ReadAll(req.Body)
+		if err != nil {
+			t.Error(err)
+			res.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		recievedReq = string(bodyBytes)
+		recievedPath = req.URL.Path
+	}))
+	defer func() { testServer.Close() }()
+
+	c, err := NewClient(ClientConfig{
+		APIEndpoint:   testServer.URL,
+		RecordType:    RecordTypeRequestItem,
+		CatalogItemID: "someCatalogItemID",
+	})
+	require.NoError(t, err)
+
+	_, err = c.CreateIncident(context.Background(), "someRequestID", RequestData{
+		User:          "someUser",
+		Roles:         []string{"role1", "role2"},
+		RequestReason: "someReason",
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "/api/now/table/sc_req_item", recievedPath)
+
+	expected := RequestItem{
+		CorrelationID:    "someRequestID",
+		CatalogItem:      "someCatalogItemID",
+		ShortDescription: "Teleport access request from user someUser",
+		Description:      "Teleport user someUser submitted access request for roles role1, role2 on Teleport cluster unknown.\nReason: someReason\n\n",
+		RequestedFor:     "someUser",
+		Variables: map[string]string{
+			"access_request_id": "someRequestID",
+			"requested_for":     "someUser",
+		},
+	}
+	var got RequestItem
+	err = json.Unmarshal([]byte(recievedReq), &got)
+	assert.NoError(t, err)
+
+	assert.Equal(t, expected, got)
+}
+
+func TestResolveRequestItem(t *testing.T) {
+	recievedReq := ""
+	recievedPath := ""
+	testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		bodyBytes, err := io.ReadAll(req.Body)
+		if err != nil {
+			t.Error(err)
+			res.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		recievedReq = string(bodyBytes)
+		recievedPath = req.URL.Path
+	}))
+	defer func() { testServer.Close() }()
+
+	c, err := NewClient(ClientConfig{
+		APIEndpoint:   testServer.URL,
+		CloseCode:     "denied",
+		RecordType:    RecordTypeRequestItem,
+		CatalogItemID: "someCatalogItemID",
+	})
+	require.NoError(t, err)
+
+	err = c.ResolveIncident(context.Background(), "someRequestItemID", Resolution{
+		Reason: "someReason",
+		State:  ResolutionStateClosed,
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "/api/now/table/sc_req_item/someRequestItemID", recievedPath)
+
+	expected := RequestItem{
+		CloseNotes: "Access request has been denied\nReason: someReason",
+		State:      RequestItemStateClosedIncomplete,
+		Approval:   RequestItemApprovalRejected,
+	}
+	var got RequestItem
+	err = json.Unmarshal([]byte(recievedReq), &got)
+	assert.NoError(t, err)
+
+	assert.Equal(t, expected, got)
+}
+
+func TestNewClientRequestItemWithoutCatalogItem(t *testing.T) {
+	_, err := NewClient(ClientConfig{
+		APIEndpoint: "https://example.com",
+		RecordType:  RecordTypeRequestItem,
+	})
+	require.Error(t, err)
+	require.True(t, trace.IsBadParameter(err))
+}
+
+func TestRequestMiddleware(t *testing.T) {
+	recievedHeader := ""
+	testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		recievedHeader = req.Header.Get("X-Custom-Header")
+	}))
+	defer func() { testServer.Close() }()
+
+	c, err := NewClient(ClientConfig{
+		APIEndpoint: testServer.URL,
+		RequestMiddleware: []resty.RequestMiddleware{
+			func(client *resty.Client, req *resty.Request) error {
+				req.SetHeader("X-Custom-Header", "someValue")
+				return nil
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = c.CreateIncident(context.Background(), "someRequestID", RequestData{User: "someUser"})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "someValue", recievedHeader)
+}
+
+func TestCreateIncidentDedupWindow(t *testing.T) {
+	var recievedQuery url.Values
+	reuse := true
+	createCalls := 0
+	testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		if req.Method == http.MethodPost {
+			createCalls++
+			res.Header().Add("Content-Type", "application/json")
+			res.Write([]byte(`{"result": {"sys_id": "newIncidentID"}}`))
+			return
+		}
+		if !strings.Contains(req.URL.Path, "/incident") {
+			// User sys_id lookups are not under test here.
+			return
+		}
+		recievedQuery = req.URL.Query()
+		res.Header().Add("Content-Type", "application/json")
+		if reuse {
+			res.Write([]byte(`{"result": [{"sys_id": "existingIncidentID", "correlation_id": "someRequestID"}]}`))
+			return
+		}
+		res.Write([]byte(`{"result": []}`))
+	}))
+	defer func() { testServer.Close() }()
+
+	c, err := NewClient(ClientConfig{
+		APIEndpoint: testServer.URL,
+		DedupWindow: time.Hour,
+	})
+	require.NoError(t, err)
+	now := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+	c.clock = clockwork.NewFakeClockAt(now)
+
+	// An open incident created within the window is reused without creating a
+	// new one, and the lookup cutoff sits exactly one window in the past.
+	incident, err := c.CreateIncident(context.Background(), "someRequestID", RequestData{User: "someUser"})
+	assert.NoError(t, err)
+	assert.Equal(t, "existingIncidentID", incident.IncidentID)
+	assert.Equal(t, 0, createCalls)
+	require.Equal(t, "correlation_id=someRequestID^active=true^sys_created_on>=2023-05-01 11:00:00", recievedQuery.Get("sysparm_query"))
+	require.Equal(t, "1", recievedQuery.Get("sysparm_limit"))
+
+	// Without an incident inside the window a fresh one is created.
+	reuse = false
+	incident, err = c.CreateIncident(context.Background(), "someRequestID", RequestData{User: "someUser"})
+	assert.NoError(t, err)
+	assert.Equal(t, "newIncidentID", incident.IncidentID)
+	assert.Equal(t, 1, createCalls)
+}
+
+func TestListOpenIncidents(t *testing.T) {
+	var recievedQuery url.Values
+	testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		recievedQuery = req.URL.Query()
+		res.Header().Add("Content-Type", "application/json")
+		res.Write([]byte(`{"result": [
+			{"sys_id": "someIncidentID", "correlation_id": "someRequestID"},
+			{"sys_id": "otherIncidentID", "correlation_id": "otherRequestID"}
+		]}`))
+	}))
+	defer func() { testServer.Close() }()
+
+	c, err := NewClient(ClientConfig{
+		APIEndpoint: testServer.URL,
+	})
+	require.NoError(t, err)
+
+	incidents, err := c.ListOpenIncidents(context.Background(), 100)
+	assert.NoError(t, err)
+
+	require.Equal(t, "active=true^correlation_idISNOTEMPTY", recievedQuery.Get("sysparm_query"))
+	require.Equal(t, "sys_id,correlation_id", recievedQuery.Get("sysparm_fields"))
+	require.Equal(t, "100", recievedQuery.Get("sysparm_limit"))
+
+	expected := []Incident{
+		{IncidentID: "someIncidentID", CorrelationID: "someRequestID"},
+		{IncidentID: "otherIncidentID", CorrelationID: "otherRequestID"},
+	}
+	assert.Equal(t, expected, incidents)
+}
+
+func TestTraceContextPropagation(t *testing.T) {
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	recievedTraceparent := ""
+	testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		recievedTraceparent = req.Header.Get("traceparent")
+	}))
+	defer func() { testServer.Close() }()
+
+	c, err := NewClient(ClientConfig{
+		APIEndpoint: testServer.URL,
+	})
+	require.NoError(t, err)
+
+	// Without a span in the context no trace context is injected.
+	_, err = c.CreateIncident(context.Background(), "someRequestID", RequestData{User: "someUser"})
+	assert.NoError(t, err)
+	assert.Empty(t, recievedTraceparent)
+
+	// With a valid span context the traceparent header is set.
+	spanCtx := oteltrace.NewSpanContext(oteltrace.SpanContextConfig{
+		TraceID: oteltrace.TraceID{1},
+		SpanID:  oteltrace.SpanID{2},
+	})
+	ctx := oteltrace.ContextWithSpanContext(context.Background(), spanCtx)
+	_, err = c.CreateIncident(ctx, "someRequestID", RequestData{User: "someUser"})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, recievedTraceparent)
+}
+
+func TestNewClientInvalidRecordType(t *testing.T) {
+	_, err := NewClient(ClientConfig{
+		APIEndpoint: "https://teleport-test.service-now.com",
+		RecordType:  "problem",
+	})
+	assert.True(t, trace.IsBadParameter(err))
+}
+
+func TestGetIncidentSLA(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		require.Equal(t, "task=someIncidentID", req.URL.Query().Get("sysparm_query"))
+		res.Header().Set("Content-Type", "application/json")
+		_, err := res.Write([]byte(`{"result": [{
+			"sla": {"value": "someSLAID"},
+			"percentage": "87.5",
+			"has_breached": "false",
+			"planned_end_time": "2023-10-01 12:00:00"
+		}]}`))
+		require.NoError(t, err)
+	}))
+	defer func() { testServer.Close() }()
+
+	c, err := NewClient(ClientConfig{
+		APIEndpoint: testServer.URL,
+	})
+	require.NoError(t, err)
+
+	slas, err := c.GetIncidentSLA(context.Background(), "someIncidentID")
+	require.NoError(t, err)
+
+	expected := []SLAStatus{{
+		SLAID:       "someSLAID",
+		Percentage:  87.5,
+		HasBreached: false,
+		BreachTime:  time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC),
+	}}
+	assert.Equal(t, expected, slas)
+}
+
+func TestGetIncidentSLAEmpty(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-Type", "application/json")
+		_, err := res.Write([]byte(`{"result": []}`))
+		require.NoError(t, err)
+	}))
+	defer func() { testServer.Close() }()
+
+	c, err := NewClient(ClientConfig{
+		APIEndpoint: testServer.URL,
+	})
+	require.NoError(t, err)
+
+	slas, err := c.GetIncidentSLA(context.Background(), "someIncidentID")
+	require.NoError(t, err)
+	assert.Empty(t, slas)
+}
+
+func TestCreateIncidentResolvesUsers(t *testing.T) {
+	lookups := 0
+	recievedReq := ""
+	testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/api/now/table/sys_user" {
+			lookups++
+			res.Header().Set("Content-Type", "application/json")
+			_, err := res.Write([]byte(`{"result": [{"sys_id": "someSysID"}]}`))
+			require.NoError(t, err)
+			return
+		}
+		bodyBytes, err := io.ReadAll(req.Body)
+		if err != nil {
+			t.Error(err)
+			res.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		recievedReq = string(bodyBytes)
+	}))
+	defer func() { testServer.Close() }()
+
+	c, err := NewClient(ClientConfig{
+		APIEndpoint: testServer.URL,
+	})
+	require.NoError(t, err)
+
+	_, err = c.CreateIncident(context.Background(), "someRequestID", RequestData{
+		User:               "someUser",
+		SuggestedReviewers: []string{"someReviewer", "otherReviewer"},
+	})
+	assert.NoError(t, err)
+
+	var got Incident
+	err = json.Unmarshal([]byte(recievedReq), &got)
+	assert.NoError(t, err)
+	assert.Equal(t, "someSysID", got.Caller)
+	assert.Equal(t, "someSysID", got.AssignedTo)
+	assert.Equal(t, "someSysID", got.WatchList)
+
+	// Each distinct user is looked up once, repeated incidents hit the cache.
+	assert.Equal(t, 3, lookups)
+	_, err = c.CreateIncident(context.Background(), "otherRequestID", RequestData{
+		User:               "someUser",
+		SuggestedReviewers: []string{"someReviewer", "otherReviewer"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, lookups)
+}
+
 func TestCreateIncidentError(t *testing.T) {
 	testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
 		res.WriteHeader(http.StatusForbidden)
@@ -155,3 +668,426 @@ func TestCreateIncidentError(t *testing.T) {
 	_, err = c.CreateIncident(context.Background(), "someRequestID", RequestData{})
 	assert.True(t, trace.IsAccessDenied(err))
 }
+
+func TestAttachImageToNote(t *testing.T) {
+	recievedReq := ""
+	testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/api/now/attachment/file" {
+			require.Equal(t, "incident", req.URL.Query().Get("table_name"))
+			require.Equal(t, "someIncidentID", req.URL.Query().Get("table_sys_id"))
+			require.Equal(t, "diagram.png", req.URL.Query().Get("file_name"))
+			require.Equal(t, "image/png", req.Header.Get("Content-Type"))
+			res.Header().Set("Content-Type", "application/json")
+			_, err := res.Write([]byte(`{"result": {"sys_id": "someAttachmentID"}}`))
+			require.NoError(t, err)
+			return
+		}
+		bodyBytes, err := io.ReadAll(req.Body)
+		if err != nil {
+			t.Error(err)
+			res.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		recievedReq = string(bodyBytes)
+	}))
+	defer func() { testServer.Close() }()
+
+	c, err := NewClient(ClientConfig{
+		APIEndpoint: testServer.URL,
+	})
+	require.NoError(t, err)
+
+	reference, err := c.AttachImageToNote(context.Background(), "someIncidentID", "diagram.png", "image/png", []byte("someImageData"))
+	require.NoError(t, err)
+	assert.Equal(t, `[code]<img src="/sys_attachment.do?sys_id=someAttachmentID"/>[/code]`, reference)
+
+	var got Incident
+	err = json.Unmarshal([]byte(recievedReq), &got)
+	assert.NoError(t, err)
+	assert.Equal(t, reference, got.WorkNotes)
+}
+
+func TestAttachImageToNoteInvalid(t *testing.T) {
+	c, err := NewClient(ClientConfig{
+		APIEndpoint: "https://teleport-test.service-now.com",
+	})
+	require.NoError(t, err)
+
+	_, err = c.AttachImageToNote(context.Background(), "someIncidentID", "diagram.png", "image/png", nil)
+	assert.True(t, trace.IsBadParameter(err))
+
+	_, err = c.AttachImageToNote(context.Background(), "someIncidentID", "diagram.png", "image/png", make([]byte, maxAttachmentSize+1))
+	assert.True(t, trace.IsBadParameter(err))
+
+	_, err = c.AttachImageToNote(context.Background(), "someIncidentID", "notes.pdf", "application/pdf", []byte("someData"))
+	assert.True(t, trace.IsBadParameter(err))
+}
+
+func TestStatusCodeMapping(t *testing.T) {
+	statusCode := http.StatusConflict
+	testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(statusCode)
+	}))
+	defer func() { testServer.Close() }()
+
+	c, err := NewClient(ClientConfig{
+		APIEndpoint: testServer.URL,
+		StatusCodeMapping: map[int]ErrorKind{
+			http.StatusConflict:     ErrorKindLimitExceeded,
+			http.StatusUnauthorized: ErrorKindAccessDenied,
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = c.CreateIncident(context.Background(), "someRequestID", RequestData{})
+	assert.True(t, trace.IsLimitExceeded(err))
+
+	statusCode = http.StatusUnauthorized
+	_, err = c.CreateIncident(context.Background(), "someRequestID", RequestData{})
+	assert.True(t, trace.IsAccessDenied(err))
+
+	// The default mapping still applies to codes that were not overridden.
+	statusCode = http.StatusForbidden
+	_, err = c.CreateIncident(context.Background(), "someRequestID", RequestData{})
+	assert.True(t, trace.IsAccessDenied(err))
+}
+
+func TestNewClientInvalidStatusCodeMapping(t *testing.T) {
+	_, err := NewClient(ClientConfig{
+		APIEndpoint: "https://teleport-test.service-now.com",
+		StatusCodeMapping: map[int]ErrorKind{
+			http.StatusConflict: "retryable",
+		},
+	})
+	assert.True(t, trace.IsBadParameter(err))
+}
+
+func TestResolveIncidentWithNotesBatch(t *testing.T) {
+	var recievedBatch batchRequest
+	patchCalls := 0
+	testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		if req.Method == http.MethodPatch {
+			patchCalls++
+			return
+		}
+		if req.URL.Path != "/api/now/v1/batch" {
+			return
+		}
+		if err := json.NewDecoder(req.Body).Decode(&recievedBatch); err != nil {
+			t.Error(err)
+			res.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		result := batchResult{}
+		for _, restRequest := range recievedBatch.RestRequests {
+			result.ServicedRequests = append(result.ServicedRequests, struct {
+				ID         string `json:"id"`
+				StatusCode int    `json:"status_code"`
+				Body       string `json:"body"`
+			}{ID: restRequest.ID, StatusCode: http.StatusOK})
+		}
+		if err := json.NewEncoder(res).Encode(result); err != nil {
+			t.Error(err)
+		}
+	}))
+	defer func() { testServer.Close() }()
+
+	c, err := NewClient(ClientConfig{
+		APIEndpoint:  testServer.URL,
+		CloseCode:    "approved",
+		BatchUpdates: true,
+	})
+	require.NoError(t, err)
+
+	err = c.ResolveIncidentWithNotes(context.Background(), "someIncidentID", Resolution{
+		Reason: "someReason",
+		State:  "6",
+	}, []string{"someNote"})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 0, patchCalls)
+	require.Len(t, recievedBatch.RestRequests, 2)
+	assert.Equal(t, "someIncidentID", recievedBatch.BatchRequestID)
+	for _, restRequest := range recievedBatch.RestRequests {
+		assert.Equal(t, http.MethodPatch, restRequest.Method)
+		assert.Equal(t, "/api/now/v1/table/incident/someIncidentID", restRequest.URL)
+	}
+
+	resolveBody, err := base64.StdEncoding.DecodeString(recievedBatch.RestRequests[0].Body)
+	require.NoError(t, err)
+	var gotResolve Incident
+	require.NoError(t, json.Unmarshal(resolveBody, &gotResolve))
+	assert.Equal(t, Incident{
+		CloseNotes:    "Access request has been approved\nReason: someReason",
+		CloseCode:     "approved",
+		IncidentState: "6",
+	}, gotResolve)
+
+	noteBody, err := base64.StdEncoding.DecodeString(recievedBatch.RestRequests[1].Body)
+	require.NoError(t, err)
+	var gotNote Incident
+	require.NoError(t, json.Unmarshal(noteBody, &gotNote))
+	assert.Equal(t, Incident{WorkNotes: "someNote"}, gotNote)
+}
+
+func TestResolveIncidentWithNotesFallback(t *testing.T) {
+	var recievedPaths []string
+	testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/api/now/v1/batch" {
+			res.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if req.Method == http.MethodPatch {
+			recievedPaths = append(recievedPaths, req.URL.Path)
+		}
+	}))
+	defer func() { testServer.Close() }()
+
+	c, err := NewClient(ClientConfig{
+		APIEndpoint:  testServer.URL,
+		CloseCode:    "approved",
+		BatchUpdates: true,
+	})
+	require.NoError(t, err)
+
+	err = c.ResolveIncidentWithNotes(context.Background(), "someIncidentID", Resolution{
+		Reason: "someReason",
+		State:  "6",
+	}, []string{"someNote"})
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"/api/now/v1/table/incident/someIncidentID",
+		"/api/now/v1/table/incident/someIncidentID",
+	}, recievedPaths)
+}
+
+func TestGetOnCallAt(t *testing.T) {
+	recievedDateTime := ""
+	testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.Contains(req.URL.Path, "/whoisoncall"):
+			recievedDateTime = req.URL.Query().Get("date_time")
+			err := json.NewEncoder(res).Encode(onCallResult{Result: []struct {
+				UserID string `json:"userId"`
+			}{{UserID: "someUserID"}}})
+			if err != nil {
+				t.Error(err)
+			}
+		case strings.Contains(req.URL.Path, "/sys_user"):
+			err := json.NewEncoder(res).Encode(userResult{Result: []struct {
+				Email string `json:"email"`
+			}{{Email: "someUser@example.com"}}})
+			if err != nil {
+				t.Error(err)
+			}
+		}
+	}))
+	defer func() { testServer.Close() }()
+
+	c, err := NewClient(ClientConfig{
+		APIEndpoint: testServer.URL,
+	})
+	require.NoError(t, err)
+
+	at := time.Date(2023, 5, 1, 12, 30, 0, 0, time.UTC)
+	emails, err := c.GetOnCallAt(context.Background(), "someRotaID", at)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"someUser@example.com"}, emails)
+	assert.Equal(t, "2023-05-01 12:30:00", recievedDateTime)
+
+	// GetOnCall queries with the current time.
+	c.clock = clockwork.NewFakeClockAt(at.Add(time.Hour))
+	emails, err = c.GetOnCall(context.Background(), "someRotaID")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"someUser@example.com"}, emails)
+	assert.Equal(t, "2023-05-01 13:30:00", recievedDateTime)
+}
+
+func TestCreateIncidentCMDBLinkage(t *testing.T) {
+	recievedReq := ""
+	recievedCIQuery := ""
+	ciMatches := true
+	testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		switch {
+		case req.Method == http.MethodPost:
+			bodyBytes, err := io.ReadAll(req.Body)
+			if err != nil {
+				t.Error(err)
+				res.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			recievedReq = string(bodyBytes)
+		case strings.Contains(req.URL.Path, "/cmdb_ci"):
+			recievedCIQuery = req.URL.Query().Get("sysparm_query")
+			res.Header().Set("Content-Type", "application/json")
+			result := ciResult{}
+			if ciMatches {
+				result.Result = []struct {
+					SysID        string `json:"sys_id"`
+					SupportGroup struct {
+						Value string `json:"value"`
+					} `json:"support_group"`
+				}{{SysID: "someCISysID"}}
+				result.Result[0].SupportGroup.Value = "someGroupSysID"
+			}
+			if err := json.NewEncoder(res).Encode(result); err != nil {
+				t.Error(err)
+			}
+		}
+	}))
+	defer func() { testServer.Close() }()
+
+	c, err := NewClient(ClientConfig{
+		APIEndpoint: testServer.URL,
+		CMDBCIMapping: map[string]string{
+			"node-1": "prod-db-server",
+		},
+		AssignFromCMDB: true,
+	})
+	require.NoError(t, err)
+
+	_, err = c.CreateIncident(context.Background(), "someRequestID", RequestData{
+		User:      "someUser",
+		Roles:     []string{"role1"},
+		Resources: []string{"node-1"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "name=prod-db-server", recievedCIQuery)
+
+	var got Incident
+	require.NoError(t, json.Unmarshal([]byte(recievedReq), &got))
+	assert.Equal(t, "someCISysID", got.CmdbCI)
+	assert.Equal(t, "someGroupSysID", got.AssignmentGroup)
+
+	// Incident creation proceeds without linkage when no CI matches.
+	ciMatches = false
+	_, err = c.CreateIncident(context.Background(), "someRequestID", RequestData{
+		User:      "someUser",
+		Roles:     []string{"role1"},
+		Resources: []string{"node-1"},
+	})
+	assert.NoError(t, err)
+
+	got = Incident{}
+	require.NoError(t, json.Unmarshal([]byte(recievedReq), &got))
+	assert.Empty(t, got.CmdbCI)
+	assert.Empty(t, got.AssignmentGroup)
+}
+
+func TestCreateIncidentRateLimit(t *testing.T) {
+	createCount := 0
+	testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		if req.Method == http.MethodPost {
+			createCount++
+		}
+	}))
+	defer func() { testServer.Close() }()
+
+	c, err := NewClient(ClientConfig{
+		APIEndpoint:         testServer.URL,
+		CreateIncidentRate:  50,
+		CreateIncidentBurst: 1,
+	})
+	require.NoError(t, err)
+
+	// With a burst of 1 the limiter spaces consecutive calls at least 1/rate
+	// apart, so 4 calls take at least 3 limiter intervals.
+	const calls = 4
+	start := time.Now()
+	for i := 0; i < calls; i++ {
+		_, err := c.CreateIncident(context.Background(), "someRequestID", RequestData{User: "someUser"})
+		require.NoError(t, err)
+	}
+	elapsed := time.Since(start)
+
+	assert.Equal(t, calls, createCount)
+	assert.GreaterOrEqual(t, elapsed, (calls-1)*time.Second/50)
+
+	// A blocked call respects context cancellation instead of waiting out the
+	// limiter.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = c.CreateIncident(ctx, "someRequestID", RequestData{User: "someUser"})
+	require.Error(t, err)
+}
+
+func TestCreateIncidentLinkBasePath(t *testing.T) {
+	recievedReq := ""
+	testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		bodyBytes, err := io.ReadAll(req.Body)
+		if err != nil {
+			t.Error(err)
+			res.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		recievedReq = string(bodyBytes)
+	}))
+	defer func() { testServer.Close() }()
+
+	webProxyURL, err := url.Parse("https://teleport.example.com")
+	require.NoError(t, err)
+
+	c, err := NewClient(ClientConfig{
+		APIEndpoint:  testServer.URL,
+		WebProxyURL:  webProxyURL,
+		LinkBasePath: "/teleport",
+	})
+	require.NoError(t, err)
+
+	_, err = c.CreateIncident(context.Background(), "someRequestID", RequestData{
+		User:          "someUser",
+		RequestReason: "someReason",
+	})
+	assert.NoError(t, err)
+
+	var got Incident
+	require.NoError(t, json.Unmarshal([]byte(recievedReq), &got))
+	assert.Contains(t, got.Description, "https://teleport.example.com/teleport/web/requests/someRequestID")
+}
+
+func TestNewClientInvalidLinkBasePath(t *testing.T) {
+	_, err := NewClient(ClientConfig{
+		APIEndpoint:  "https://example.com",
+		LinkBasePath: "/teleport//web",
+	})
+	require.Error(t, err)
+	require.True(t, trace.IsBadParameter(err))
+}
+
+func TestResolveIncidentWithNotesBatchServicedError(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-Type", "application/json")
+		result := batchResult{}
+		result.ServicedRequests = append(result.ServicedRequests, struct {
+			ID         string `json:"id"`
+			StatusCode int    `json:"status_code"`
+			Body       string `json:"body"`
+		}{
+			ID:         "1",
+			StatusCode: http.StatusForbidden,
+			// Serviced request bodies are returned base64-encoded.
+			Body: base64.StdEncoding.EncodeToString([]byte("someDetailedError")),
+		})
+		if err := json.NewEncoder(res).Encode(result); err != nil {
+			t.Error(err)
+		}
+	}))
+	defer func() { testServer.Close() }()
+
+	c, err := NewClient(ClientConfig{
+		APIEndpoint:  testServer.URL,
+		CloseCode:    "approved",
+		BatchUpdates: true,
+	})
+	require.NoError(t, err)
+
+	err = c.ResolveIncidentWithNotes(context.Background(), "someIncidentID", Resolution{
+		Reason: "someReason",
+		State:  "6",
+	}, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "someDetailedError")
+	assert.NotContains(t, err.Error(), base64.StdEncoding.EncodeToString([]byte("someDetailedError")))
+}
@@ -31,6 +31,11 @@ type Config struct {
 	common.BaseConfig
 	ClientConfig
 	ServiceNow common.GenericAPIConfig
+
+	// ReconcileOnStartup enables resolving open incidents whose access
+	// requests are no longer pending when the plugin starts, e.g. requests
+	// denied or expired while the plugin was down.
+	ReconcileOnStartup bool `toml:"reconcile_on_startup"`
 }
 
 // CheckAndSetDefaults checks the config struct for any logical errors, and sets default values
@@ -48,6 +48,7 @@ func DecodePluginData(dataMap map[string]string) (data PluginData, err error) {
 	data.Resolution.State = dataMap["state"]
 	data.Resolution.Reason = dataMap["resolve_reason"]
 	data.IncidentID = dataMap["incident_id"]
+	data.ResolutionPending = dataMap["resolution_pending"] != ""
 	return
 }
 
@@ -74,5 +75,11 @@ func EncodePluginData(data PluginData) map[string]string {
 
 	result["state"] = data.Resolution.State
 	result["resolve_reason"] = data.Resolution.Reason
+
+	var resolutionPendingStr string
+	if data.ResolutionPending {
+		resolutionPendingStr = "true"
+	}
+	result["resolution_pending"] = resolutionPendingStr
 	return result
 }
@@ -0,0 +1,88 @@
+/*
+Copyright 2023 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicenow
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var samplePluginData = PluginData{
+	RequestData: RequestData{
+		User:          "user-foo",
+		Roles:         []string{"role-foo", "role-bar"},
+		Created:       time.Date(2023, 6, 1, 13, 27, 17, 0, time.UTC).Local(),
+		RequestReason: "foo reason",
+		ReviewsCount:  3,
+		Resolution:    Resolution{State: ResolutionStateResolved, Reason: "foo ok"},
+	},
+	ServiceNowData: ServiceNowData{
+		IncidentID:        "INCIDENT1",
+		ResolutionPending: true,
+	},
+}
+
+func TestEncodePluginData(t *testing.T) {
+	dataMap := EncodePluginData(samplePluginData)
+	assert.Len(t, dataMap, 9)
+	assert.Equal(t, "user-foo", dataMap["user"])
+	assert.Equal(t, "role-foo,role-bar", dataMap["roles"])
+	assert.Equal(t, "1685626037", dataMap["created"])
+	assert.Equal(t, "foo reason", dataMap["request_reason"])
+	assert.Equal(t, "3", dataMap["reviews_count"])
+	assert.Equal(t, ResolutionStateResolved, dataMap["state"])
+	assert.Equal(t, "foo ok", dataMap["resolve_reason"])
+	assert.Equal(t, "INCIDENT1", dataMap["incident_id"])
+	assert.Equal(t, "true", dataMap["resolution_pending"])
+}
+
+func TestDecodePluginData(t *testing.T) {
+	pluginData, err := DecodePluginData(map[string]string{
+		"user":               "user-foo",
+		"roles":              "role-foo,role-bar",
+		"created":            "1685626037",
+		"request_reason":     "foo reason",
+		"reviews_count":      "3",
+		"state":              ResolutionStateResolved,
+		"resolve_reason":     "foo ok",
+		"incident_id":        "INCIDENT1",
+		"resolution_pending": "true",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, samplePluginData, pluginData)
+}
+
+func TestEncodeEmptyPluginData(t *testing.T) {
+	dataMap := EncodePluginData(PluginData{})
+	assert.Len(t, dataMap, 9)
+	for key, value := range dataMap {
+		assert.Emptyf(t, value, "value at key %q must be empty", key)
+	}
+}
+
+func TestDecodeEmptyPluginData(t *testing.T) {
+	pluginData, err := DecodePluginData(nil)
+	require.NoError(t, err)
+	assert.Empty(t, pluginData)
+
+	pluginData, err = DecodePluginData(make(map[string]string))
+	require.NoError(t, err)
+	assert.Empty(t, pluginData)
+}
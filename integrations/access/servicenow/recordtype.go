@@ -0,0 +1,192 @@
+/*
+Copyright 2023 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicenow
+
+import "github.com/gravitational/trace"
+
+const (
+	// RecordTypeIncident targets the ServiceNow Incident module.
+	RecordTypeIncident = "incident"
+	// RecordTypeChangeRequest targets the ServiceNow Change Management module.
+	RecordTypeChangeRequest = "change_request"
+	// RecordTypeRequestItem targets the ServiceNow Service Catalog, creating
+	// request items (RITMs) for a configured catalog item.
+	RecordTypeRequestItem = "sc_req_item"
+)
+
+// recordType abstracts the ServiceNow table targeted by the client so the
+// same create/review/resolve flow can serve either incidents or change
+// requests. The client builds generic Incident bodies and the record type
+// maps them onto the fields and state model of its table.
+type recordType interface {
+	// tableName returns the name of the record table.
+	tableName() string
+	// tablePath returns the API path of the record table.
+	tablePath() string
+	// recordPath returns the API path of a single record with the sys_id
+	// path parameter left for substitution.
+	recordPath() string
+	// createBody maps the generic record onto the table's creation fields.
+	createBody(incident Incident) any
+	// noteBody maps a work note onto the table's note fields.
+	noteBody(note string) any
+	// resolveBody maps the generic resolution record onto the table's
+	// resolution fields, translating incident states into the table's state
+	// model.
+	resolveBody(incident Incident) any
+}
+
+// incidentRecord is the default record type, preserving the original
+// incident-table behavior.
+type incidentRecord struct{}
+
+func (incidentRecord) tableName() string  { return RecordTypeIncident }
+func (incidentRecord) tablePath() string  { return "/api/now/v1/table/incident" }
+func (incidentRecord) recordPath() string { return "/api/now/v1/table/incident/{sys_id}" }
+
+func (incidentRecord) createBody(incident Incident) any { return incident }
+
+func (incidentRecord) noteBody(note string) any { return Incident{WorkNotes: note} }
+
+func (incidentRecord) resolveBody(incident Incident) any { return incident }
+
+// changeRequestRecord targets the change_request table.
+type changeRequestRecord struct{}
+
+func (changeRequestRecord) tableName() string  { return RecordTypeChangeRequest }
+func (changeRequestRecord) tablePath() string  { return "/api/now/table/change_request" }
+func (changeRequestRecord) recordPath() string { return "/api/now/table/change_request/{sys_id}" }
+
+func (changeRequestRecord) createBody(incident Incident) any {
+	// Teleport access requests are reviewed in Teleport, not by a CAB.
+	cabRequired := false
+	return ChangeRequest{
+		CorrelationID:    incident.CorrelationID,
+		ShortDescription: incident.ShortDescription,
+		Description:      incident.Description,
+		RequestedBy:      incident.Caller,
+		AssignedTo:       incident.AssignedTo,
+		WatchList:        incident.WatchList,
+		CabRequired:      &cabRequired,
+	}
+}
+
+func (changeRequestRecord) noteBody(note string) any { return ChangeRequest{WorkNotes: note} }
+
+func (changeRequestRecord) resolveBody(incident Incident) any {
+	return ChangeRequest{
+		State:      changeRequestState(incident.IncidentState),
+		CloseCode:  incident.CloseCode,
+		CloseNotes: incident.CloseNotes,
+		Comments:   incident.Comments,
+	}
+}
+
+// changeRequestState translates an incident resolution state into the
+// change_request state model.
+func changeRequestState(incidentState string) string {
+	switch incidentState {
+	case ResolutionStateResolved:
+		return ChangeRequestStateClosed
+	case ResolutionStateClosed:
+		return ChangeRequestStateCanceled
+	}
+	return incidentState
+}
+
+// requestItemRecord targets the sc_req_item table, creating catalog request
+// items tied to the configured catalog item so they flow through the
+// organization's standard fulfillment workflow.
+type requestItemRecord struct {
+	// catalogItemID is the sys_id of the catalog item request items are
+	// created for.
+	catalogItemID string
+}
+
+func (requestItemRecord) tableName() string  { return RecordTypeRequestItem }
+func (requestItemRecord) tablePath() string  { return "/api/now/table/sc_req_item" }
+func (requestItemRecord) recordPath() string { return "/api/now/table/sc_req_item/{sys_id}" }
+
+func (r requestItemRecord) createBody(incident Incident) any {
+	return RequestItem{
+		CorrelationID:    incident.CorrelationID,
+		CatalogItem:      r.catalogItemID,
+		ShortDescription: incident.ShortDescription,
+		Description:      incident.Description,
+		RequestedFor:     incident.Caller,
+		AssignedTo:       incident.AssignedTo,
+		WatchList:        incident.WatchList,
+		// Expose the request identity as catalog variables so fulfillment
+		// workflows keyed on variables can act on it.
+		Variables: map[string]string{
+			"access_request_id": incident.CorrelationID,
+			"requested_for":     incident.Caller,
+		},
+	}
+}
+
+func (requestItemRecord) noteBody(note string) any { return RequestItem{WorkNotes: note} }
+
+func (requestItemRecord) resolveBody(incident Incident) any {
+	return RequestItem{
+		State:      requestItemState(incident.IncidentState),
+		Approval:   requestItemApproval(incident.IncidentState),
+		CloseNotes: incident.CloseNotes,
+		Comments:   incident.Comments,
+	}
+}
+
+// requestItemState translates an incident resolution state into the catalog
+// fulfillment state model.
+func requestItemState(incidentState string) string {
+	switch incidentState {
+	case ResolutionStateResolved:
+		return RequestItemStateClosedComplete
+	case ResolutionStateClosed:
+		return RequestItemStateClosedIncomplete
+	}
+	return incidentState
+}
+
+// requestItemApproval translates an incident resolution state into the
+// request item approval state.
+func requestItemApproval(incidentState string) string {
+	switch incidentState {
+	case ResolutionStateResolved:
+		return RequestItemApprovalApproved
+	case ResolutionStateClosed:
+		return RequestItemApprovalRejected
+	}
+	return ""
+}
+
+// newRecordType returns the record type implementation for the given
+// ClientConfig.
+func newRecordType(conf ClientConfig) (recordType, error) {
+	switch conf.RecordType {
+	case "", RecordTypeIncident:
+		return incidentRecord{}, nil
+	case RecordTypeChangeRequest:
+		return changeRequestRecord{}, nil
+	case RecordTypeRequestItem:
+		if conf.CatalogItemID == "" {
+			return nil, trace.BadParameter("missing required field for record type %q: CatalogItemID", RecordTypeRequestItem)
+		}
+		return requestItemRecord{catalogItemID: conf.CatalogItemID}, nil
+	}
+	return nil, trace.BadParameter("unsupported record type: %q", conf.RecordType)
+}
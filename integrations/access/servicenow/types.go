@@ -32,12 +32,19 @@ type PluginData struct {
 type ServiceNowData struct {
 	// IncidentID is the serviceNow sys_id of the incident
 	IncidentID string
+	// ResolutionPending indicates that the request was resolved in Teleport but
+	// the incident update did not reach ServiceNow yet and must be retried.
+	ResolutionPending bool
 }
 
 // Incident represents a serviceNow incident.
 type Incident struct {
 	// IncidentID is the sys_id of the incident
 	IncidentID string `json:"sys_id,omitempty"`
+	// CorrelationID is the Teleport access request ID the incident was created
+	// for, so plugin-created incidents can be found and tied back to their
+	// requests.
+	CorrelationID string `json:"correlation_id,omitempty"`
 	// ShortDescription contains a brief summary of the incident.
 	ShortDescription string `json:"short_description,omitempty"`
 	// Description contains the description of the incident.
@@ -50,10 +57,19 @@ type Incident struct {
 	IncidentState string `json:"incident_state,omitempty"`
 	// WorkNotes contains comments on the progress of the incident.
 	WorkNotes string `json:"work_notes,omitempty"`
+	// Comments contains customer-visible comments on the incident.
+	Comments string `json:"comments,omitempty"`
 	// Caller is the user on whose behalf the incident is being created. (Must be an existing servicenow user)
 	Caller string `json:"caller_id,omitempty"`
 	// AssignedTo is the ServiceNow user the incident is assigned.
 	AssignedTo string `json:"assigned_to,omitempty"`
+	// WatchList is a comma-separated list of ServiceNow users watching the incident.
+	WatchList string `json:"watch_list,omitempty"`
+	// CmdbCI is the sys_id of the CMDB configuration item the incident is
+	// linked to.
+	CmdbCI string `json:"cmdb_ci,omitempty"`
+	// AssignmentGroup is the sys_id of the group the incident is assigned to.
+	AssignmentGroup string `json:"assignment_group,omitempty"`
 }
 
 const (
@@ -64,8 +80,94 @@ const (
 	ResolutionStateResolved = "6"
 	// ResolutionStateClosed is the incident state for a closed incident
 	ResolutionStateClosed = "7"
+
+	// Change requests use a different state model than incidents.
+	// https://support.servicenow.com/kb?id=kb_article_view&sysparm_article=KB0746148
+
+	// ChangeRequestStateClosed is the change request state for a closed change request.
+	ChangeRequestStateClosed = "3"
+	// ChangeRequestStateCanceled is the change request state for a canceled change request.
+	ChangeRequestStateCanceled = "4"
+
+	// Request items use the catalog fulfillment state model.
+
+	// RequestItemStateClosedComplete is the request item state for a fulfilled request item.
+	RequestItemStateClosedComplete = "3"
+	// RequestItemStateClosedIncomplete is the request item state for a request item closed without fulfillment.
+	RequestItemStateClosedIncomplete = "4"
+
+	// RequestItemApprovalApproved marks a request item as approved.
+	RequestItemApprovalApproved = "approved"
+	// RequestItemApprovalRejected marks a request item as rejected.
+	RequestItemApprovalRejected = "rejected"
 )
 
+// ChangeRequest represents a serviceNow change request.
+type ChangeRequest struct {
+	// ChangeRequestID is the sys_id of the change request
+	ChangeRequestID string `json:"sys_id,omitempty"`
+	// CorrelationID is the Teleport access request ID the change request was
+	// created for.
+	CorrelationID string `json:"correlation_id,omitempty"`
+	// ShortDescription contains a brief summary of the change request.
+	ShortDescription string `json:"short_description,omitempty"`
+	// Description contains the description of the change request.
+	Description string `json:"description,omitempty"`
+	// CabRequired indicates whether the change must go through a Change
+	// Advisory Board. A pointer so an explicit false survives omitempty.
+	CabRequired *bool `json:"cab_required,omitempty"`
+	// State contains the current state the change request is in.
+	State string `json:"state,omitempty"`
+	// CloseCode contains the close code of the change request once it is closed.
+	CloseCode string `json:"close_code,omitempty"`
+	// CloseNotes contains the closing comments on the change request once it is closed.
+	CloseNotes string `json:"close_notes,omitempty"`
+	// WorkNotes contains comments on the progress of the change request.
+	WorkNotes string `json:"work_notes,omitempty"`
+	// Comments contains customer-visible comments on the change request.
+	Comments string `json:"comments,omitempty"`
+	// RequestedBy is the user on whose behalf the change request is being created. (Must be an existing servicenow user)
+	RequestedBy string `json:"requested_by,omitempty"`
+	// AssignedTo is the ServiceNow user the change request is assigned.
+	AssignedTo string `json:"assigned_to,omitempty"`
+	// WatchList is a comma-separated list of ServiceNow users watching the change request.
+	WatchList string `json:"watch_list,omitempty"`
+}
+
+// RequestItem represents a serviceNow catalog request item (RITM).
+type RequestItem struct {
+	// RequestItemID is the sys_id of the request item
+	RequestItemID string `json:"sys_id,omitempty"`
+	// CorrelationID is the Teleport access request ID the request item was
+	// created for.
+	CorrelationID string `json:"correlation_id,omitempty"`
+	// CatalogItem is the sys_id of the catalog item the request item is created for.
+	CatalogItem string `json:"cat_item,omitempty"`
+	// ShortDescription contains a brief summary of the request item.
+	ShortDescription string `json:"short_description,omitempty"`
+	// Description contains the description of the request item.
+	Description string `json:"description,omitempty"`
+	// State contains the current fulfillment state the request item is in.
+	State string `json:"state,omitempty"`
+	// Approval contains the approval state of the request item.
+	Approval string `json:"approval,omitempty"`
+	// CloseNotes contains the closing comments on the request item once it is closed.
+	CloseNotes string `json:"close_notes,omitempty"`
+	// WorkNotes contains comments on the progress of the request item.
+	WorkNotes string `json:"work_notes,omitempty"`
+	// Comments contains customer-visible comments on the request item.
+	Comments string `json:"comments,omitempty"`
+	// RequestedFor is the user on whose behalf the request item is being created. (Must be an existing servicenow user)
+	RequestedFor string `json:"requested_for,omitempty"`
+	// AssignedTo is the ServiceNow user the request item is assigned.
+	AssignedTo string `json:"assigned_to,omitempty"`
+	// WatchList is a comma-separated list of ServiceNow users watching the request item.
+	WatchList string `json:"watch_list,omitempty"`
+	// Variables are the catalog item variables the request item is created
+	// with, for fulfillment workflows keyed on them.
+	Variables map[string]string `json:"variables,omitempty"`
+}
+
 // Resolution stores the resolution state and the servicenow close code.
 type Resolution struct {
 	// State is the state of the servicenow incident
@@ -96,6 +198,35 @@ type RequestData struct {
 	SuggestedReviewers []string
 }
 
+// SLAStatus represents the status of an SLA attached to an incident.
+type SLAStatus struct {
+	// SLAID is the sys_id of the SLA definition.
+	SLAID string
+	// Percentage is the percentage of the SLA's allotted time that has elapsed.
+	Percentage float64
+	// BreachTime is the time at which the SLA is (or was) breached.
+	// It is the zero time when the SLA has no planned end time.
+	BreachTime time.Time
+	// HasBreached indicates whether the SLA has already been breached.
+	HasBreached bool
+}
+
+type taskSLAResult struct {
+	Result []struct {
+		// SLA is the reference to the SLA definition.
+		SLA struct {
+			// Value is the sys_id of the SLA definition.
+			Value string `json:"value"`
+		} `json:"sla"`
+		// Percentage is the percentage of the SLA's allotted time that has elapsed.
+		Percentage string `json:"percentage"`
+		// HasBreached indicates whether the SLA has already been breached.
+		HasBreached string `json:"has_breached"`
+		// PlannedEndTime is the time at which the SLA will be breached.
+		PlannedEndTime string `json:"planned_end_time"`
+	} `json:"result"`
+}
+
 type onCallResult struct {
 	Result []struct {
 		// UserID is the ID of the on-call user.
@@ -110,6 +241,85 @@ type userResult struct {
 	} `json:"result"`
 }
 
+type attachmentResult struct {
+	Result struct {
+		// SysID is the sys_id of the uploaded attachment.
+		SysID string `json:"sys_id"`
+	} `json:"result"`
+}
+
+type ciResult struct {
+	Result []struct {
+		// SysID is the sys_id of the configuration item.
+		SysID string `json:"sys_id"`
+		// SupportGroup is the reference to the CI's support group.
+		SupportGroup struct {
+			// Value is the sys_id of the support group.
+			Value string `json:"value"`
+		} `json:"support_group"`
+	} `json:"result"`
+}
+
+type userSysIDResult struct {
+	Result []struct {
+		// SysID is the sys_id of the requested user.
+		SysID string `json:"sys_id"`
+	} `json:"result"`
+}
+
+// batchRequest is the body of a ServiceNow Batch API request, grouping
+// several REST requests into one round trip.
+type batchRequest struct {
+	// BatchRequestID identifies the batch in the response.
+	BatchRequestID string `json:"batch_request_id"`
+	// RestRequests are the individual requests to service.
+	RestRequests []batchRestRequest `json:"rest_requests"`
+}
+
+// batchRestRequest is a single REST request within a batch.
+type batchRestRequest struct {
+	// ID correlates the request with its entry in the batch response.
+	ID string `json:"id"`
+	// Method is the HTTP method of the request.
+	Method string `json:"method"`
+	// URL is the relative URL of the request.
+	URL string `json:"url"`
+	// Headers are the headers of the request.
+	Headers []batchHeader `json:"headers"`
+	// Body is the base64-encoded body of the request.
+	Body string `json:"body"`
+}
+
+// batchHeader is a header on a request within a batch.
+type batchHeader struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type batchResult struct {
+	// ServicedRequests are the results of the requests that were serviced.
+	ServicedRequests []struct {
+		// ID correlates the result with the request it belongs to.
+		ID string `json:"id"`
+		// StatusCode is the status code the request was serviced with.
+		StatusCode int `json:"status_code"`
+		// Body is the base64-encoded body of the response.
+		Body string `json:"body"`
+	} `json:"serviced_requests"`
+	// UnservicedRequests are the IDs of the requests that were not serviced.
+	UnservicedRequests []string `json:"unserviced_requests"`
+}
+
+type listIncidentsResult struct {
+	Result []struct {
+		// IncidentID is the sys_id of the incident
+		IncidentID string `json:"sys_id"`
+		// CorrelationID is the Teleport access request ID recorded on the
+		// incident at creation time.
+		CorrelationID string `json:"correlation_id"`
+	} `json:"result"`
+}
+
 type incidentResult struct {
 	Result struct {
 		// IncidentID is the sys_id of the incident
@@ -0,0 +1,109 @@
+/*
+Copyright 2023 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicenow
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/gravitational/trace"
+	"github.com/jonboulle/clockwork"
+	"golang.org/x/sync/singleflight"
+)
+
+// defaultUserCacheTTL is how long resolved user sys_ids are reused before
+// being looked up again.
+const defaultUserCacheTTL = 10 * time.Minute
+
+// userSysIDCache caches email/username to sys_id lookups so that busy plugins
+// don't re-query the sys_user table for every incident. Entries expire after
+// a TTL, concurrent lookups for the same key are collapsed into a single
+// request and failed lookups are never cached.
+type userSysIDCache struct {
+	ttl   time.Duration
+	clock clockwork.Clock
+	// group collapses concurrent lookups for the same key.
+	group singleflight.Group
+
+	mu      sync.Mutex
+	entries map[string]userSysIDCacheEntry
+}
+
+type userSysIDCacheEntry struct {
+	sysID   string
+	expires time.Time
+}
+
+func newUserSysIDCache(ttl time.Duration, clock clockwork.Clock) *userSysIDCache {
+	if ttl <= 0 {
+		ttl = defaultUserCacheTTL
+	}
+	return &userSysIDCache{
+		ttl:     ttl,
+		clock:   clock,
+		entries: make(map[string]userSysIDCacheEntry),
+	}
+}
+
+// resolve returns the cached sys_id for the given key or looks it up via fn.
+func (c *userSysIDCache) resolve(ctx context.Context, key string, fn func(ctx context.Context) (string, error)) (string, error) {
+	if sysID, ok := c.get(key); ok {
+		return sysID, nil
+	}
+
+	sysID, err, _ := c.group.Do(key, func() (any, error) {
+		// Another caller might have refreshed the entry while we were waiting
+		// on the group.
+		if sysID, ok := c.get(key); ok {
+			return sysID, nil
+		}
+
+		sysID, err := fn(ctx)
+		if err != nil {
+			return "", trace.Wrap(err)
+		}
+		c.mu.Lock()
+		c.entries[key] = userSysIDCacheEntry{sysID: sysID, expires: c.clock.Now().Add(c.ttl)}
+		c.mu.Unlock()
+		return sysID, nil
+	})
+	if err != nil {
+		// Drop any stale entry so the next call retries the lookup.
+		c.invalidate(key)
+		return "", trace.Wrap(err)
+	}
+	return sysID.(string), nil
+}
+
+// get returns the cached sys_id for the given key if it hasn't expired.
+func (c *userSysIDCache) get(key string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok || !c.clock.Now().Before(entry.expires) {
+		return "", false
+	}
+	return entry.sysID, true
+}
+
+// invalidate removes the cached entry for the given key.
+func (c *userSysIDCache) invalidate(key string) {
+	c.mu.Lock()
+	delete(c.entries, key)
+	c.mu.Unlock()
+}
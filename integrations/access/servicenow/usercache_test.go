@@ -0,0 +1,72 @@
+/*
+Copyright 2023 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicenow
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gravitational/trace"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserSysIDCacheTTL(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+	cache := newUserSysIDCache(time.Minute, clock)
+	ctx := context.Background()
+
+	lookups := 0
+	lookup := func(ctx context.Context) (string, error) {
+		lookups++
+		return "someSysID", nil
+	}
+
+	// The first resolution performs the lookup, subsequent ones hit the cache.
+	sysID, err := cache.resolve(ctx, "someUser", lookup)
+	require.NoError(t, err)
+	assert.Equal(t, "someSysID", sysID)
+	sysID, err = cache.resolve(ctx, "someUser", lookup)
+	require.NoError(t, err)
+	assert.Equal(t, "someSysID", sysID)
+	assert.Equal(t, 1, lookups)
+
+	// Once the TTL elapses the entry is looked up again.
+	clock.Advance(2 * time.Minute)
+	_, err = cache.resolve(ctx, "someUser", lookup)
+	require.NoError(t, err)
+	assert.Equal(t, 2, lookups)
+}
+
+func TestUserSysIDCacheLookupFailure(t *testing.T) {
+	cache := newUserSysIDCache(time.Minute, clockwork.NewFakeClock())
+	ctx := context.Background()
+
+	// Failed lookups are not cached.
+	_, err := cache.resolve(ctx, "someUser", func(ctx context.Context) (string, error) {
+		return "", trace.NotFound("no user found")
+	})
+	assert.True(t, trace.IsNotFound(err))
+
+	sysID, err := cache.resolve(ctx, "someUser", func(ctx context.Context) (string, error) {
+		return "someSysID", nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "someSysID", sysID)
+}
@@ -18,6 +18,7 @@ package sidecar
 
 import (
 	"path/filepath"
+	"time"
 
 	"github.com/gravitational/trace"
 	log "github.com/sirupsen/logrus"
@@ -38,6 +39,13 @@ const (
 	DefaultDataDir    = "/var/lib/teleport"
 	DefaultUser       = "teleport-operator-sidecar"
 	DefaultRole       = "teleport-operator-sidecar"
+
+	// DefaultClientRetryBackoff is the base backoff between attempts to build
+	// the bot client.
+	DefaultClientRetryBackoff = 5 * time.Second
+	// DefaultClientMaxRetries is the number of times building the bot client
+	// is retried before giving up.
+	DefaultClientMaxRetries = 5
 )
 
 // Options configure the sidecar connection.
@@ -56,6 +64,14 @@ type Options struct {
 
 	// Role is a role allowed to manage Teleport resources.
 	Role string
+
+	// ClientRetryBackoff is the base backoff between attempts to build the bot
+	// client, so connection establishment tolerates brief Auth unavailability.
+	ClientRetryBackoff time.Duration
+
+	// ClientMaxRetries is the number of times building the bot client is
+	// retried before the failure is surfaced to the reconciliation loop.
+	ClientMaxRetries int
 }
 
 func createAuthClientConfig(opts Options) (*authclient.Config, error) {
@@ -145,5 +161,11 @@ func (opts *Options) CheckAndSetDefaults() error {
 	if opts.DataDir == "" {
 		opts.DataDir = DefaultDataDir
 	}
+	if opts.ClientRetryBackoff == 0 {
+		opts.ClientRetryBackoff = DefaultClientRetryBackoff
+	}
+	if opts.ClientMaxRetries == 0 {
+		opts.ClientMaxRetries = DefaultClientMaxRetries
+	}
 	return nil
 }
@@ -31,6 +31,7 @@ import (
 	"github.com/gravitational/teleport/api/client"
 	"github.com/gravitational/teleport/api/client/proto"
 	"github.com/gravitational/teleport/api/types"
+	"github.com/gravitational/teleport/api/utils/retryutils"
 	"github.com/gravitational/teleport/lib/auth"
 	"github.com/gravitational/teleport/lib/auth/authclient"
 	"github.com/gravitational/teleport/lib/tbot"
@@ -136,6 +137,47 @@ func (b *Bot) buildClient(ctx context.Context) (*SyncClient, error) {
 	return NewSyncClient(c), trace.Wrap(err)
 }
 
+// buildClientWithRetry invokes build until it succeeds or the retry budget is
+// exhausted, backing off with jitter between attempts. Dialing the Auth Server
+// can fail transiently, e.g. during an Auth restart, and such failures should
+// not surface as hard reconciliation errors.
+func buildClientWithRetry(ctx context.Context, build func(context.Context) (*SyncClient, error), backoff time.Duration, maxRetries int) (*SyncClient, error) {
+	if maxRetries <= 0 {
+		return build(ctx)
+	}
+
+	retry, err := retryutils.NewLinear(retryutils.LinearConfig{
+		First:  utils.FullJitter(backoff),
+		Step:   backoff,
+		Max:    time.Duration(maxRetries) * backoff,
+		Jitter: retryutils.NewHalfJitter(),
+	})
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	var lastErr error
+	for attempt := 0; attempt <= maxRetries; attempt++ {
+		c, err := build(ctx)
+		if err == nil {
+			return c, nil
+		}
+		lastErr = err
+		if attempt == maxRetries {
+			break
+		}
+
+		log.WithError(err).Infof("Failed to build teleport client, retrying (attempt %d of %d)", attempt+1, maxRetries)
+		select {
+		case <-retry.After():
+			retry.Inc()
+		case <-ctx.Done():
+			return nil, trace.Wrap(ctx.Err())
+		}
+	}
+	return nil, trace.Wrap(lastErr, "building teleport client after %d attempts", maxRetries+1)
+}
+
 // GetSyncClient gets a client authenticated as the bot. To avoid rebuilding a
 // client for each call, this function caches the client and creates a new one
 // only when the client certs changed (tbot renewed them).
@@ -272,7 +314,9 @@ func CreateAndBootstrapBot(ctx context.Context, opts Options) (*Bot, *proto.Feat
 		opts:       opts,
 	}
 
-	bot.clientBuilder = bot.buildClient
+	bot.clientBuilder = func(ctx context.Context) (*SyncClient, error) {
+		return buildClientWithRetry(ctx, bot.buildClient, opts.ClientRetryBackoff, opts.ClientMaxRetries)
+	}
 	bot.initializeConfig(ctx)
 
 	return bot, ping.ServerFeatures, nil
@@ -20,7 +20,9 @@ import (
 	"context"
 	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/gravitational/trace"
 	"github.com/stretchr/testify/require"
 
 	"github.com/gravitational/teleport/lib/tbot/config"
@@ -131,3 +133,42 @@ func TestBot_GetClient(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildClientWithRetry(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("succeeds after transient failures", func(t *testing.T) {
+		var attempts atomic.Int32
+		build := func(context.Context) (*SyncClient, error) {
+			if attempts.Add(1) < 3 {
+				return nil, trace.ConnectionProblem(nil, "auth unavailable")
+			}
+			return NewSyncClient(nil), nil
+		}
+		c, err := buildClientWithRetry(ctx, build, time.Millisecond, 5)
+		require.NoError(t, err)
+		require.NotNil(t, c)
+		require.Equal(t, int32(3), attempts.Load())
+	})
+
+	t.Run("gives up once the retry budget is exhausted", func(t *testing.T) {
+		var attempts atomic.Int32
+		build := func(context.Context) (*SyncClient, error) {
+			attempts.Add(1)
+			return nil, trace.ConnectionProblem(nil, "auth unavailable")
+		}
+		_, err := buildClientWithRetry(ctx, build, time.Millisecond, 2)
+		require.Error(t, err)
+		require.Equal(t, int32(3), attempts.Load())
+	})
+
+	t.Run("honors context cancellation between attempts", func(t *testing.T) {
+		cancelCtx, cancel := context.WithCancel(ctx)
+		cancel()
+		build := func(context.Context) (*SyncClient, error) {
+			return nil, trace.ConnectionProblem(nil, "auth unavailable")
+		}
+		_, err := buildClientWithRetry(cancelCtx, build, time.Minute, 2)
+		require.ErrorIs(t, err, context.Canceled)
+	})
+}
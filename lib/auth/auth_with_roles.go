@@ -6953,7 +6953,9 @@ func (a *ServerWithRoles) UpdateHeadlessAuthenticationState(ctx context.Context,
 	if err != nil && eventCode == events.UserHeadlessLoginApprovedCode {
 		eventCode = events.UserHeadlessLoginApprovedFailureCode
 	}
-	emitHeadlessLoginEvent(ctx, eventCode, a.authServer.emitter, headlessAuthn, err)
+	// Emit the replacement resource so that approval events carry the MFA
+	// device that approved the authentication.
+	emitHeadlessLoginEvent(ctx, eventCode, a.authServer.emitter, &replaceHeadlessAuthn, err)
 	return trace.Wrap(err)
 }
 
@@ -7160,6 +7162,13 @@ func emitHeadlessLoginEvent(ctx context.Context, code string, emitter apievents.
 		},
 	}
 
+	// Record the device used for approval, if any. Resources updated by older
+	// Auth servers won't have the device set.
+	if headlessAuthn.MfaDevice != nil {
+		m := mfaDeviceEventMetadata(headlessAuthn.MfaDevice)
+		event.MFADevice = &m
+	}
+
 	if emitErr := emitter.EmitAuditEvent(ctx, &event); emitErr != nil {
 		log.WithError(err).Warnf("Failed to emit %q login event, code %q: %v", events.LoginMethodHeadless, code, emitErr)
 	}
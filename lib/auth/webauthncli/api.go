@@ -264,6 +264,30 @@ func HasPlatformSupport() bool {
 	return IsFIDO2Available() || touchid.IsAvailable() || isU2FAvailable()
 }
 
+// AuthenticatorsDetected reports whether at least one authenticator usable for
+// login is currently connected.
+// detected is only meaningful if supported is true; platforms or build modes
+// that cannot enumerate devices report supported=false.
+func AuthenticatorsDetected() (detected, supported bool) {
+	switch {
+	case wanwin.IsAvailable():
+		// The Windows WebAuthn API doesn't expose device enumeration.
+		return false, false
+	case touchid.IsAvailable():
+		// Touch ID is a built-in platform authenticator, it's always "connected".
+		return true, true
+	case isLibfido2Enabled():
+		detected, err := fido2DevicesPresent()
+		if err != nil {
+			log.WithError(err).Debug("FIDO2: Failed to enumerate devices")
+			return false, false
+		}
+		return detected, true
+	}
+	// U2F-only builds can't enumerate devices.
+	return false, false
+}
+
 // IsFIDO2Available returns true if FIDO2 is implemented either via native
 // libfido2 library or Windows WebAuthn API.
 func IsFIDO2Available() bool {
@@ -849,6 +849,16 @@ func findAndSelectDevice(ctx context.Context, filter deviceFilterFunc, deviceCal
 	}
 }
 
+// fido2DevicesPresent returns whether at least one FIDO2 device is currently
+// connected, without opening or touching any devices.
+func fido2DevicesPresent() (bool, error) {
+	locs, err := fidoDeviceLocations()
+	if err != nil {
+		return false, trace.Wrap(err, "device locations")
+	}
+	return len(locs) > 0, nil
+}
+
 func findDevices(knownPaths map[string]struct{}) ([]*deviceWithInfo, error) {
 	locs, err := fidoDeviceLocations()
 	if err != nil {
@@ -39,6 +39,10 @@ func fido2Login(
 	return nil, "", errFIDO2Unavailable
 }
 
+func fido2DevicesPresent() (bool, error) {
+	return false, errFIDO2Unavailable
+}
+
 func fido2Register(
 	ctx context.Context,
 	origin string, cc *wantypes.CredentialCreation, prompt RegisterPrompt,
@@ -20,12 +20,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/gravitational/trace"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 	oteltrace "go.opentelemetry.io/otel/trace"
 
 	"github.com/gravitational/teleport"
@@ -35,6 +38,7 @@ import (
 	wancli "github.com/gravitational/teleport/lib/auth/webauthncli"
 	wantypes "github.com/gravitational/teleport/lib/auth/webauthntypes"
 	"github.com/gravitational/teleport/lib/auth/webauthnwin"
+	"github.com/gravitational/teleport/lib/utils"
 )
 
 // AdminMFAHintBeforePrompt is a hint used for MFA prompts for admin-level API requests.
@@ -73,6 +77,17 @@ type Prompt struct {
 	PreferOTP bool
 	// WebauthnSupported indicates whether Webauthn is supported.
 	WebauthnSupported bool
+	// CountdownInterval enables a periodic "Xs remaining" countdown line on
+	// the prompt writer while waiting for MFA, refreshed at the given interval
+	// until the context deadline. It is disabled when zero, when Quiet is set
+	// or when the context has no deadline.
+	CountdownInterval time.Duration
+	// SlowPromptThreshold enables "awaiting MFA" instrumentation: once the
+	// ceremony has been waiting longer than the threshold, a structured log
+	// line is emitted and the ceremony span is annotated at every threshold
+	// multiple. It distinguishes a user who has not tapped their key yet from
+	// a stuck network. Disabled when zero.
+	SlowPromptThreshold time.Duration
 }
 
 // PromptOpt applies configuration options to a prompt.
@@ -92,6 +107,20 @@ func WithHintBeforePrompt(hint string) PromptOpt {
 	}
 }
 
+// WithCountdownInterval sets the prompt's CountdownInterval field.
+func WithCountdownInterval(interval time.Duration) PromptOpt {
+	return func(p *Prompt) {
+		p.CountdownInterval = interval
+	}
+}
+
+// WithSlowPromptThreshold sets the prompt's SlowPromptThreshold field.
+func WithSlowPromptThreshold(threshold time.Duration) PromptOpt {
+	return func(p *Prompt) {
+		p.SlowPromptThreshold = threshold
+	}
+}
+
 // WithPromptDevicePrefix sets the prompt's PromptDevicePrefix field.
 func WithPromptDevicePrefix(prefix string) PromptOpt {
 	return func(p *Prompt) {
@@ -99,6 +128,99 @@ func WithPromptDevicePrefix(prefix string) PromptOpt {
 	}
 }
 
+// RequestContext describes the access request that an MFA prompt is gating.
+type RequestContext struct {
+	// User is the user who created the access request.
+	User string
+	// Roles are the roles being requested.
+	Roles []string
+	// Resources are the resources being requested.
+	Resources []string
+	// Duration is the requested access duration.
+	Duration time.Duration
+}
+
+// WithPromptReasonFromRequest sets the prompt's HintBeforePrompt field to a
+// standardized, human-readable reason built from the access request, so that
+// every call site prompts with identical phrasing. Use [WithHintBeforePrompt]
+// for custom messages.
+func WithPromptReasonFromRequest(req RequestContext) PromptOpt {
+	return func(p *Prompt) {
+		p.HintBeforePrompt = promptReasonFromRequest(req)
+	}
+}
+
+// promptReasonFromRequest builds the hint message for the given access request.
+func promptReasonFromRequest(req RequestContext) string {
+	var b strings.Builder
+	b.WriteString("MFA is required to approve the access request")
+	if req.User != "" {
+		fmt.Fprintf(&b, " from user %q", req.User)
+	}
+	if len(req.Roles) > 0 {
+		fmt.Fprintf(&b, " for roles [%s]", strings.Join(req.Roles, ", "))
+	}
+	if len(req.Resources) > 0 {
+		fmt.Fprintf(&b, " to resources [%s]", strings.Join(req.Resources, ", "))
+	}
+	if req.Duration > 0 {
+		fmt.Fprintf(&b, " lasting %v", req.Duration)
+	}
+	b.WriteString(".")
+	return b.String()
+}
+
+// Capabilities describes what a prompt will do for a given challenge, so UIs
+// can pre-render the right affordances (OTP input, "tap your key" hint) before
+// the ceremony runs. It is computed with the same rules the ceremony uses, so
+// it matches what [Prompt.Run] will actually do.
+type Capabilities struct {
+	// TOTP indicates that the prompt will read an OTP code.
+	TOTP bool
+	// Webauthn indicates that the prompt will wait for a security key or
+	// platform authenticator.
+	Webauthn bool
+	// StdinHijacked indicates that the prompt will read an OTP code from
+	// stdin while the Webauthn prompt is active.
+	StdinHijacked bool
+	// PreferredMethod is the method the prompt favors when several are
+	// available, either "TOTP" or "WEBAUTHN". Empty when the challenge has no
+	// method usable on this platform.
+	PreferredMethod string
+}
+
+// GetCapabilities returns the capability descriptor for the given challenge
+// without starting the ceremony.
+func (p *Prompt) GetCapabilities(chal *proto.MFAAuthenticateChallenge) Capabilities {
+	hasTOTP := chal.TOTP != nil
+	hasWebauthn := chal.WebauthnChallenge != nil && p.WebauthnSupported
+
+	// Tweak enabled/disabled methods according to opts.
+	switch {
+	case hasTOTP && p.PreferOTP:
+		hasWebauthn = false
+	case hasWebauthn && p.AuthenticatorAttachment != wancli.AttachmentAuto:
+		// Prefer Webauthn if an specific attachment was requested.
+		hasTOTP = false
+	case hasWebauthn && !p.AllowStdinHijack:
+		// Use strongest auth if hijack is not allowed.
+		hasTOTP = false
+	}
+
+	caps := Capabilities{
+		TOTP:          hasTOTP,
+		Webauthn:      hasWebauthn,
+		StdinHijacked: hasTOTP && hasWebauthn,
+	}
+	switch {
+	case hasWebauthn:
+		caps.PreferredMethod = "WEBAUTHN"
+	case hasTOTP:
+		caps.PreferredMethod = "TOTP"
+	}
+	return caps
+}
+
 // NewPrompt creates a new prompt with standard behavior.
 // If you want to customize [Prompt], for example for testing purposes, you may
 // create or configure an instance directly, without calling this method.
@@ -136,51 +258,37 @@ func (p *Prompt) Run(ctx context.Context, chal *proto.MFAAuthenticateChallenge)
 
 	quiet := p.Quiet
 
-	hasTOTP := chal.TOTP != nil
-	hasWebauthn := chal.WebauthnChallenge != nil
-
-	// Does the current platform support hardware MFA? Adjust accordingly.
-	switch {
-	case !hasTOTP && !p.WebauthnSupported:
+	// Compute which methods the ceremony will prompt for. The capability
+	// descriptor applies the same platform support and option tweaks, so UIs
+	// asking ahead of time see exactly what happens below.
+	caps := p.GetCapabilities(chal)
+	if !caps.TOTP && !caps.Webauthn {
 		return nil, trace.BadParameter("hardware device MFA not supported by your platform, please register an OTP device")
-	case !p.WebauthnSupported:
-		// Do not prompt for hardware devices, it won't work.
-		hasWebauthn = false
 	}
-
-	// Tweak enabled/disabled methods according to opts.
-	switch {
-	case hasTOTP && p.PreferOTP:
-		hasWebauthn = false
-	case hasWebauthn && p.AuthenticatorAttachment != wancli.AttachmentAuto:
-		// Prefer Webauthn if an specific attachment was requested.
-		hasTOTP = false
-	case hasWebauthn && !p.AllowStdinHijack:
-		// Use strongest auth if hijack is not allowed.
-		hasTOTP = false
+	hasTOTP := caps.TOTP
+	hasWebauthn := caps.Webauthn
+
+	// If Webauthn is the only method left and the platform lets us enumerate
+	// devices, fail fast when no authenticator is connected instead of blocking
+	// on the OS prompt until it times out.
+	if hasWebauthn && !hasTOTP && !p.AllowStdinHijack {
+		if detected, supported := wancli.AuthenticatorsDetected(); supported && !detected {
+			return nil, trace.NotFound("no security key detected, connect a security key and try again")
+		}
 	}
 
-	var numGoroutines int
-	if hasTOTP && hasWebauthn {
-		numGoroutines = 2
-	} else {
-		numGoroutines = 1
-	}
+	// Use ctx to clean up after ourselves.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
 
-	type response struct {
-		kind string
-		resp *proto.MFAAuthenticateResponse
-		err  error
+	if p.CountdownInterval > 0 && !quiet {
+		stopCountdown := startCountdown(ctx, writer, p.CountdownInterval)
+		defer stopCountdown()
 	}
-	respC := make(chan response, numGoroutines)
 
-	// Use ctx and wg to clean up after ourselves.
-	ctx, cancel := context.WithCancel(ctx)
-	defer cancel()
-	var wg sync.WaitGroup
-	cancelAndWait := func() {
-		cancel()
-		wg.Wait()
+	if p.SlowPromptThreshold > 0 {
+		stopSlowPromptMonitor := startSlowPromptMonitor(ctx, span, p.SlowPromptThreshold)
+		defer stopSlowPromptMonitor()
 	}
 
 	// Use variables below to cancel OTP reads and make sure the goroutine exited.
@@ -188,15 +296,20 @@ func (p *Prompt) Run(ctx context.Context, chal *proto.MFAAuthenticateChallenge)
 	otpCtx, otpCancel := context.WithCancel(ctx)
 	defer otpCancel()
 
-	// Fire TOTP goroutine.
+	tasks := make([]func(context.Context) (*proto.MFAAuthenticateResponse, error), 0, 2)
+
+	// TOTP task.
 	if hasTOTP {
 		otpWait.Add(1)
-		wg.Add(1)
-		go func() {
+		tasks = append(tasks, func(taskCtx context.Context) (*proto.MFAAuthenticateResponse, error) {
 			defer otpWait.Done()
-			defer wg.Done()
 			const kind = "TOTP"
 
+			// The OTP read is canceled both when the race is over and, via
+			// otpCancel, when the Webauthn prompt needs the terminal.
+			stopAfterFunc := context.AfterFunc(taskCtx, otpCancel)
+			defer stopAfterFunc()
+
 			// Let Webauthn take the prompt, it knows better if it's necessary.
 			var msg string
 			if !quiet && !hasWebauthn {
@@ -205,32 +318,28 @@ func (p *Prompt) Run(ctx context.Context, chal *proto.MFAAuthenticateChallenge)
 
 			otp, err := prompt.Password(otpCtx, writer, prompt.Stdin(), msg)
 			if err != nil {
-				respC <- response{kind: kind, err: err}
-				return
+				log.WithError(err).Debugf("%s authentication failed", kind)
+				return nil, trace.Wrap(err)
 			}
-			respC <- response{
-				kind: kind,
-				resp: &proto.MFAAuthenticateResponse{
-					Response: &proto.MFAAuthenticateResponse_TOTP{
-						TOTP: &proto.TOTPResponse{Code: otp},
-					},
+			return &proto.MFAAuthenticateResponse{
+				Response: &proto.MFAAuthenticateResponse_TOTP{
+					TOTP: &proto.TOTPResponse{Code: otp},
 				},
-			}
-		}()
+			}, nil
+		})
 	}
 
-	// Fire Webauthn goroutine.
+	// Webauthn task.
 	if hasWebauthn {
 		origin := p.ProxyAddress
 		if !strings.HasPrefix(origin, "https://") {
 			origin = "https://" + origin
 		}
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
+		tasks = append(tasks, func(taskCtx context.Context) (*proto.MFAAuthenticateResponse, error) {
+			const kind = "WEBAUTHN"
 			log.Debugf("WebAuthn: prompting devices with origin %q", origin)
 
-			prompt := wancli.NewDefaultPrompt(ctx, writer)
+			prompt := wancli.NewDefaultPrompt(taskCtx, writer)
 			prompt.SecondTouchMessage = fmt.Sprintf("Tap your %ssecurity key to complete login", promptDevicePrefix)
 			switch {
 			case quiet:
@@ -254,35 +363,125 @@ func (p *Prompt) Run(ctx context.Context, chal *proto.MFAAuthenticateChallenge)
 				otpWait.Wait()
 			}}
 
-			resp, _, err := p.WebauthnLogin(ctx, origin, wantypes.CredentialAssertionFromProto(chal.WebauthnChallenge), mfaPrompt, &wancli.LoginOpts{
+			resp, _, err := p.WebauthnLogin(taskCtx, origin, wantypes.CredentialAssertionFromProto(chal.WebauthnChallenge), mfaPrompt, &wancli.LoginOpts{
 				AuthenticatorAttachment: p.AuthenticatorAttachment,
 			})
-			respC <- response{kind: "WEBAUTHN", resp: resp, err: err}
-		}()
+			if err != nil {
+				if !errors.Is(err, wancli.ErrUsingNonRegisteredDevice) {
+					log.WithError(err).Debugf("%s authentication failed", kind)
+				}
+				return nil, trace.Wrap(err)
+			}
+			return resp, nil
+		})
 	}
 
-	for i := 0; i < numGoroutines; i++ {
-		select {
-		case resp := <-respC:
-			switch err := resp.err; {
-			case errors.Is(err, wancli.ErrUsingNonRegisteredDevice):
-				// Surface error immediately.
-			case err != nil:
-				log.WithError(err).Debugf("%s authentication failed", resp.kind)
-				continue
+	resp, err := utils.RaceFirst(ctx, tasks, utils.RaceOpts{
+		// Surface non-registered device errors immediately.
+		FastFail: func(err error) bool {
+			return errors.Is(err, wancli.ErrUsingNonRegisteredDevice)
+		},
+	})
+	switch {
+	case err == nil:
+		return resp, nil
+	case errors.Is(err, wancli.ErrUsingNonRegisteredDevice):
+		return nil, trace.Wrap(err)
+	case ctx.Err() != nil:
+		return nil, trace.Wrap(ctx.Err())
+	}
+	return nil, trace.BadParameter(
+		"failed to authenticate using all MFA devices, rerun the command with '-d' to see error details for each device")
+}
+
+// startCountdown periodically rewrites a "Xs remaining" line on w until the
+// context is canceled, its deadline is reached or the returned stop function
+// is called. The line is cleared when the countdown stops so output produced
+// afterwards starts on a clean line. Each refresh is a single write rewriting
+// the whole line, so updates do not leave partial output interleaved with the
+// concurrent TOTP and Webauthn prompts.
+func startCountdown(ctx context.Context, w io.Writer, interval time.Duration) (stop func()) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return func() {}
+	}
+
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		shown := false
+		// clearLine moves to the start of the line and erases it.
+		clearLine := func() {
+			if shown {
+				fmt.Fprint(w, "\r\x1b[K")
+				shown = false
+			}
+		}
+		defer clearLine()
+
+		for {
+			select {
+			case <-ticker.C:
+				remaining := time.Until(deadline)
+				if remaining <= 0 {
+					return
+				}
+				fmt.Fprintf(w, "\r\x1b[K%ds remaining", int(remaining.Round(time.Second).Seconds()))
+				shown = true
+			case <-done:
+				return
+			case <-ctx.Done():
+				return
 			}
+		}
+	}()
+	return func() {
+		close(done)
+		wg.Wait()
+	}
+}
 
-			// Cleanup in-flight goroutines.
-			cancelAndWait()
-			return resp.resp, trace.Wrap(resp.err)
-		case <-ctx.Done():
-			cancelAndWait()
-			return nil, trace.Wrap(ctx.Err())
+// startSlowPromptMonitor watches a running MFA ceremony and, at every
+// threshold multiple, logs a structured "awaiting MFA" line and annotates the
+// span when one is recording. The signal only observes the ceremony and never
+// writes to the prompt, so it cannot interfere with the concurrent TOTP and
+// Webauthn prompts. The returned stop function halts the monitor.
+func startSlowPromptMonitor(ctx context.Context, span oteltrace.Span, threshold time.Duration) (stop func()) {
+	start := time.Now()
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(threshold)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				elapsed := time.Since(start).Round(time.Second)
+				log.WithField("elapsed", elapsed).Infof("Awaiting MFA for %v", elapsed)
+				if span.IsRecording() {
+					span.AddEvent("awaiting MFA", oteltrace.WithAttributes(
+						attribute.Int64("mfa.elapsed_seconds", int64(elapsed.Seconds())),
+					))
+				}
+			case <-done:
+				return
+			case <-ctx.Done():
+				return
+			}
 		}
+	}()
+	return func() {
+		close(done)
+		wg.Wait()
 	}
-	cancelAndWait()
-	return nil, trace.BadParameter(
-		"failed to authenticate using all MFA devices, rerun the command with '-d' to see error details for each device")
 }
 
 // mfaPrompt implements wancli.LoginPrompt for MFA logins.
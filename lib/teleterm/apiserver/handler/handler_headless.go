@@ -30,3 +30,12 @@ func (s *Handler) UpdateHeadlessAuthenticationState(ctx context.Context, req *ap
 
 	return &api.UpdateHeadlessAuthenticationStateResponse{}, nil
 }
+
+// RegisterHeadlessAction records that a headless action was just initiated
+// from this device, so pending headless authentications received shortly after
+// are flagged as expected.
+func (s *Handler) RegisterHeadlessAction(ctx context.Context, req *api.RegisterHeadlessActionRequest) (*api.RegisterHeadlessActionResponse, error) {
+	s.DaemonService.RegisterHeadlessAction(req.RootClusterUri, req.ClientIp)
+
+	return &api.RegisterHeadlessActionResponse{}, nil
+}
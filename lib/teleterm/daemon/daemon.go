@@ -75,6 +75,8 @@ func New(cfg Config) (*Service, error) {
 		gateways:               make(map[string]gateway.Gateway),
 		usageReporter:          connectUsageReporter,
 		headlessWatcherClosers: make(map[string]context.CancelFunc),
+		headlessWatchersPaused: make(map[string]struct{}),
+		recentHeadlessActions:  make(map[string]headlessAction),
 	}, nil
 }
 
@@ -968,6 +970,17 @@ type Service struct {
 	// headlessWatcherClosers holds a map of root cluster URIs to headless watchers.
 	headlessWatcherClosers   map[string]context.CancelFunc
 	headlessWatcherClosersMu sync.Mutex
+	// headlessWatchersPaused holds the root cluster URIs whose headless
+	// watchers are paused. Paused watchers stay connected to the Auth Server
+	// but drop pending headless authentications instead of delivering modals.
+	headlessWatchersPaused   map[string]struct{}
+	headlessWatchersPausedMu sync.Mutex
+	// recentHeadlessActions holds, per root cluster URI, the most recent
+	// headless action initiated from this device. It is used to flag pending
+	// headless authentications that the user likely expects, so the Electron
+	// app can show a stronger warning for the ones they don't.
+	recentHeadlessActions   map[string]headlessAction
+	recentHeadlessActionsMu sync.Mutex
 }
 
 type CreateGatewayParams struct {
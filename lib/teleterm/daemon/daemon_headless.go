@@ -16,10 +16,14 @@ package daemon
 
 import (
 	"context"
+	"runtime/debug"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/gravitational/trace"
+	"github.com/jonboulle/clockwork"
+	"github.com/sirupsen/logrus"
 
 	"github.com/gravitational/teleport/api/types"
 	"github.com/gravitational/teleport/api/utils/retryutils"
@@ -129,6 +133,8 @@ func (s *Service) startHeadlessWatcher(cluster *clusters.Cluster, waitInit bool)
 		pendingRequests[name] = cancel
 	}
 
+	recentlyResolved := newRecentlyResolvedHeadless(s.cfg.Clock)
+
 	pendingWatcherInitialized := make(chan struct{})
 	pendingWatcherInitializedOnce := sync.Once{}
 
@@ -175,9 +181,25 @@ func (s *Service) startHeadlessWatcher(cluster *clusters.Cluster, waitInit bool)
 					return trace.Errorf("headless watcher returned an unexpected resource type %T", event.Resource)
 				}
 
+				// Drop prompts that arrive while the watcher is paused, e.g. while the
+				// machine is asleep. They would be stale by the time the user resumes.
+				if s.isHeadlessWatcherPaused(cluster.URI.String()) {
+					log.Debugf("Dropping pending headless authentication %v received while paused.", ha.GetName())
+					continue
+				}
+
+				// Suppress prompts for requests resolved moments ago, e.g. a retry
+				// racing with the user's approval, so a redundant modal does not
+				// appear.
+				if recentlyResolved.isRecentlyResolved(ha.GetName()) {
+					log.Debugf("Suppressing pending headless authentication %v resolved within the last %v.", ha.GetName(), headlessResolutionSuppressionWindow)
+					continue
+				}
+
 				// headless authentication requests will timeout after 3 minutes, so we can close the
-				// Electron modal once this time is up.
-				sendCtx, cancelSend := context.WithTimeout(s.closeContext, defaults.HeadlessLoginTimeout)
+				// Electron modal once this time is up. Derive the context from the watcher context
+				// so in-flight sends are aborted as soon as the cluster disconnects.
+				sendCtx, cancelSend := context.WithTimeout(watchCtx, defaults.HeadlessLoginTimeout)
 
 				// Add the pending request to the map so it is canceled early upon resolution.
 				addPendingRequest(ha.GetName(), cancelSend)
@@ -186,6 +208,13 @@ func (s *Service) startHeadlessWatcher(cluster *clusters.Cluster, waitInit bool)
 				// We do this in a goroutine so the watch loop can continue and cancel resolved requests.
 				go func() {
 					defer cancelSend()
+					// An unexpected bug in modal delivery must not take down
+					// the daemon.
+					defer func() {
+						if r := recover(); r != nil {
+							log.WithField("stack", string(debug.Stack())).Errorf("Recovered from panic in headless authentication send: %v.", r)
+						}
+					}()
 					if err := s.sendPendingHeadlessAuthentication(sendCtx, ha, cluster.URI.String()); err != nil {
 						if !strings.Contains(err.Error(), context.Canceled.Error()) && !strings.Contains(err.Error(), context.DeadlineExceeded.Error()) {
 							log.WithError(err).Debug("sendPendingHeadlessAuthentication resulted in unexpected error.")
@@ -203,9 +232,11 @@ func (s *Service) startHeadlessWatcher(cluster *clusters.Cluster, waitInit bool)
 
 					switch ha.State {
 					case types.HeadlessAuthenticationState_HEADLESS_AUTHENTICATION_STATE_APPROVED, types.HeadlessAuthenticationState_HEADLESS_AUTHENTICATION_STATE_DENIED:
+						recentlyResolved.add(ha.GetName())
 						cancelPendingRequest(ha.GetName())
 					}
 				case types.OpDelete:
+					recentlyResolved.add(event.Resource.GetName())
 					cancelPendingRequest(event.Resource.GetName())
 				}
 			case <-pendingWatcher.Done():
@@ -241,7 +272,7 @@ func (s *Service) startHeadlessWatcher(cluster *clusters.Cluster, waitInit bool)
 				return
 			}
 
-			err := watch()
+			err := watchWithRecover(log, watch)
 			if trace.IsNotImplemented(err) {
 				// Don't retry watch if we are connecting to an old Auth Server.
 				log.WithError(err).Debug("Headless watcher not supported.")
@@ -271,12 +302,124 @@ func (s *Service) startHeadlessWatcher(cluster *clusters.Cluster, waitInit bool)
 	return nil
 }
 
+// headlessResolutionSuppressionWindow is how long after a headless
+// authentication resolves that pending events for the same ID are suppressed
+// instead of shown. Retries of the same logical login can race with the
+// user's approval and would otherwise pop a second modal.
+const headlessResolutionSuppressionWindow = 5 * time.Second
+
+// recentlyResolvedHeadless tracks headless authentication IDs that resolved
+// within the suppression window.
+type recentlyResolvedHeadless struct {
+	clock clockwork.Clock
+	mu    sync.Mutex
+	// resolved maps headless authentication IDs to their resolution time.
+	resolved map[string]time.Time
+}
+
+func newRecentlyResolvedHeadless(clock clockwork.Clock) *recentlyResolvedHeadless {
+	return &recentlyResolvedHeadless{
+		clock:    clock,
+		resolved: make(map[string]time.Time),
+	}
+}
+
+// add records that the headless authentication with the given ID was resolved.
+func (r *recentlyResolvedHeadless) add(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.clock.Now()
+	// Drop entries past the suppression window so the map does not grow over
+	// a long watch session.
+	for name, resolvedAt := range r.resolved {
+		if now.Sub(resolvedAt) > headlessResolutionSuppressionWindow {
+			delete(r.resolved, name)
+		}
+	}
+	r.resolved[name] = now
+}
+
+// isRecentlyResolved returns whether the headless authentication with the
+// given ID was resolved within the suppression window.
+func (r *recentlyResolvedHeadless) isRecentlyResolved(name string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	resolvedAt, ok := r.resolved[name]
+	return ok && r.clock.Since(resolvedAt) <= headlessResolutionSuppressionWindow
+}
+
+// watchWithRecover invokes watch, converting a panic into an error so that an
+// unexpected bug in event handling restarts the watcher through the usual
+// retry path instead of taking down the daemon. The panic is logged together
+// with its stack.
+func watchWithRecover(log *logrus.Entry, watch func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.WithField("stack", string(debug.Stack())).Errorf("Recovered from panic in headless watch loop: %v.", r)
+			err = trace.Errorf("headless watch loop panicked: %v", r)
+		}
+	}()
+	return watch()
+}
+
+// headlessAction describes a headless action initiated from this device, used
+// to correlate incoming pending headless authentications with local activity.
+type headlessAction struct {
+	// initiatedAt is when the action was initiated.
+	initiatedAt time.Time
+	// clientIP is the client IP the action is expected to originate from.
+	// It may be empty, in which case only timing is used for correlation.
+	clientIP string
+}
+
+// RegisterHeadlessAction records that a headless action, such as running
+// "tsh login --headless", was just initiated from this device for the given
+// root cluster. Pending headless authentications received shortly after are
+// flagged as expected, so the Electron app can reserve its strongest warning
+// for the ones with no matching local action. clientIP may be empty if the
+// client IP the action will appear under is not known.
+func (s *Service) RegisterHeadlessAction(clusterURI, clientIP string) {
+	s.recentHeadlessActionsMu.Lock()
+	defer s.recentHeadlessActionsMu.Unlock()
+
+	s.recentHeadlessActions[clusterURI] = headlessAction{
+		initiatedAt: s.cfg.Clock.Now(),
+		clientIP:    clientIP,
+	}
+}
+
+// isExpectedHeadlessAuthentication returns whether the pending headless
+// authentication correlates with a headless action recently initiated from
+// this device. A pending authentication is considered expected when it arrives
+// within the headless login timeout of a registered action for the same
+// cluster and, if the action recorded a client IP, the IPs match.
+func (s *Service) isExpectedHeadlessAuthentication(clusterURI string, ha *types.HeadlessAuthentication) bool {
+	s.recentHeadlessActionsMu.Lock()
+	defer s.recentHeadlessActionsMu.Unlock()
+
+	action, ok := s.recentHeadlessActions[clusterURI]
+	if !ok {
+		return false
+	}
+
+	if s.cfg.Clock.Since(action.initiatedAt) > defaults.HeadlessLoginTimeout {
+		// The action is too old to match any new prompts, clean it up.
+		delete(s.recentHeadlessActions, clusterURI)
+		return false
+	}
+
+	return action.clientIP == "" || action.clientIP == ha.ClientIpAddress
+}
+
 // sendPendingHeadlessAuthentication notifies the Electron App of a pending headless authentication.
 func (s *Service) sendPendingHeadlessAuthentication(ctx context.Context, ha *types.HeadlessAuthentication, clusterURI string) error {
 	req := &api.SendPendingHeadlessAuthenticationRequest{
 		RootClusterUri:                 clusterURI,
 		HeadlessAuthenticationId:       ha.GetName(),
 		HeadlessAuthenticationClientIp: ha.ClientIpAddress,
+		Expected:                       s.isExpectedHeadlessAuthentication(clusterURI, ha),
 	}
 
 	if err := s.importantModalSemaphore.Acquire(ctx); err != nil {
@@ -288,6 +431,63 @@ func (s *Service) sendPendingHeadlessAuthentication(ctx context.Context, ha *typ
 	return trace.Wrap(err)
 }
 
+// PauseHeadlessWatcher suspends modal delivery for the given cluster URI while
+// keeping the underlying Auth watcher connected, so resuming does not pay the
+// reconnection cost. Pending headless authentications received while paused
+// are dropped.
+func (s *Service) PauseHeadlessWatcher(uri string) {
+	s.headlessWatchersPausedMu.Lock()
+	defer s.headlessWatchersPausedMu.Unlock()
+
+	s.headlessWatchersPaused[uri] = struct{}{}
+}
+
+// PauseHeadlessWatchers suspends modal delivery for all clusters with a
+// running headless watcher.
+func (s *Service) PauseHeadlessWatchers() {
+	s.headlessWatcherClosersMu.Lock()
+	uris := make([]string, 0, len(s.headlessWatcherClosers))
+	for uri := range s.headlessWatcherClosers {
+		uris = append(uris, uri)
+	}
+	s.headlessWatcherClosersMu.Unlock()
+
+	s.headlessWatchersPausedMu.Lock()
+	defer s.headlessWatchersPausedMu.Unlock()
+
+	for _, uri := range uris {
+		s.headlessWatchersPaused[uri] = struct{}{}
+	}
+}
+
+// ResumeHeadlessWatcher resumes modal delivery for the given cluster URI.
+// Prompts that accumulated while paused have been dropped and are not
+// redelivered.
+func (s *Service) ResumeHeadlessWatcher(uri string) {
+	s.headlessWatchersPausedMu.Lock()
+	defer s.headlessWatchersPausedMu.Unlock()
+
+	delete(s.headlessWatchersPaused, uri)
+}
+
+// ResumeHeadlessWatchers resumes modal delivery for all clusters.
+func (s *Service) ResumeHeadlessWatchers() {
+	s.headlessWatchersPausedMu.Lock()
+	defer s.headlessWatchersPausedMu.Unlock()
+
+	clear(s.headlessWatchersPaused)
+}
+
+// isHeadlessWatcherPaused returns whether modal delivery is suspended for the
+// given cluster URI.
+func (s *Service) isHeadlessWatcherPaused(uri string) bool {
+	s.headlessWatchersPausedMu.Lock()
+	defer s.headlessWatchersPausedMu.Unlock()
+
+	_, paused := s.headlessWatchersPaused[uri]
+	return paused
+}
+
 // StopHeadlessWatcher stops the headless watcher for the given cluster URI.
 func (s *Service) StopHeadlessWatcher(uri string) error {
 	s.headlessWatcherClosersMu.Lock()
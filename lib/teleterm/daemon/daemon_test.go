@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/gravitational/trace"
+	"github.com/jonboulle/clockwork"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -575,6 +576,9 @@ type mockTSHDEventsService struct {
 	reloginCount                           atomic.Uint32
 	sendNotificationCount                  atomic.Uint32
 	sendPendingHeadlessAuthenticationCount atomic.Uint32
+	// sendPendingHeadlessAuthenticationBlock makes SendPendingHeadlessAuthentication
+	// block until the call is canceled.
+	sendPendingHeadlessAuthenticationBlock bool
 }
 
 func newMockTSHDEventsServiceServer(t *testing.T) (service *mockTSHDEventsService, addr string) {
@@ -621,11 +625,196 @@ func (c *mockTSHDEventsService) SendNotification(context.Context, *api.SendNotif
 	return &api.SendNotificationResponse{}, nil
 }
 
-func (c *mockTSHDEventsService) SendPendingHeadlessAuthentication(context.Context, *api.SendPendingHeadlessAuthenticationRequest) (*api.SendPendingHeadlessAuthenticationResponse, error) {
+func (c *mockTSHDEventsService) SendPendingHeadlessAuthentication(ctx context.Context, _ *api.SendPendingHeadlessAuthenticationRequest) (*api.SendPendingHeadlessAuthenticationResponse, error) {
 	c.sendPendingHeadlessAuthenticationCount.Add(1)
+	if c.sendPendingHeadlessAuthenticationBlock {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
 	return &api.SendPendingHeadlessAuthenticationResponse{}, nil
 }
 
+func TestStopHeadlessWatcherCancelsPendingSends(t *testing.T) {
+	t.Parallel()
+
+	storage, err := clusters.NewStorage(clusters.Config{
+		Dir:                t.TempDir(),
+		InsecureSkipVerify: true,
+	})
+	require.NoError(t, err)
+
+	daemon, err := New(Config{
+		Storage: storage,
+		CreateTshdEventsClientCredsFunc: func() (grpc.DialOption, error) {
+			return grpc.WithTransportCredentials(insecure.NewCredentials()), nil
+		},
+		KubeconfigsDir: t.TempDir(),
+		AgentsDir:      t.TempDir(),
+	})
+	require.NoError(t, err)
+
+	service, addr := newMockTSHDEventsServiceServer(t)
+	service.sendPendingHeadlessAuthenticationBlock = true
+	err = daemon.UpdateAndDialTshdEventsServerAddress(addr)
+	require.NoError(t, err)
+
+	// Pending sends derive their context from the cluster watcher context, so
+	// stopping the watcher must promptly abort an in-flight send.
+	const clusterURI = "/clusters/foo"
+	watchCtx, watchCancel := context.WithCancel(daemon.closeContext)
+	daemon.headlessWatcherClosersMu.Lock()
+	daemon.headlessWatcherClosers[clusterURI] = watchCancel
+	daemon.headlessWatcherClosersMu.Unlock()
+
+	sendCtx, cancelSend := context.WithTimeout(watchCtx, defaults.HeadlessLoginTimeout)
+	defer cancelSend()
+
+	sendErrC := make(chan error, 1)
+	go func() {
+		sendErrC <- daemon.sendPendingHeadlessAuthentication(sendCtx, &types.HeadlessAuthentication{}, clusterURI)
+	}()
+
+	select {
+	case err := <-sendErrC:
+		t.Fatalf("sendPendingHeadlessAuthentication returned before the watcher was stopped: %v", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	require.NoError(t, daemon.StopHeadlessWatcher(clusterURI))
+
+	select {
+	case err := <-sendErrC:
+		require.Error(t, err)
+	case <-time.After(time.Second):
+		t.Error("sendPendingHeadlessAuthentication was not canceled by stopping the watcher")
+	}
+}
+
+func TestPauseAndResumeHeadlessWatchers(t *testing.T) {
+	t.Parallel()
+
+	storage, err := clusters.NewStorage(clusters.Config{
+		Dir:                t.TempDir(),
+		InsecureSkipVerify: true,
+	})
+	require.NoError(t, err)
+
+	daemon, err := New(Config{
+		Storage:        storage,
+		KubeconfigsDir: t.TempDir(),
+		AgentsDir:      t.TempDir(),
+	})
+	require.NoError(t, err)
+
+	const fooURI = "/clusters/foo"
+	const barURI = "/clusters/bar"
+	daemon.headlessWatcherClosersMu.Lock()
+	daemon.headlessWatcherClosers[fooURI] = func() {}
+	daemon.headlessWatcherClosers[barURI] = func() {}
+	daemon.headlessWatcherClosersMu.Unlock()
+
+	require.False(t, daemon.isHeadlessWatcherPaused(fooURI))
+
+	daemon.PauseHeadlessWatcher(fooURI)
+	require.True(t, daemon.isHeadlessWatcherPaused(fooURI))
+	require.False(t, daemon.isHeadlessWatcherPaused(barURI))
+
+	daemon.ResumeHeadlessWatcher(fooURI)
+	require.False(t, daemon.isHeadlessWatcherPaused(fooURI))
+
+	// The all-clusters variants cover every running watcher.
+	daemon.PauseHeadlessWatchers()
+	require.True(t, daemon.isHeadlessWatcherPaused(fooURI))
+	require.True(t, daemon.isHeadlessWatcherPaused(barURI))
+
+	daemon.ResumeHeadlessWatchers()
+	require.False(t, daemon.isHeadlessWatcherPaused(fooURI))
+	require.False(t, daemon.isHeadlessWatcherPaused(barURI))
+}
+
+func TestIsExpectedHeadlessAuthentication(t *testing.T) {
+	t.Parallel()
+
+	storage, err := clusters.NewStorage(clusters.Config{
+		Dir:                t.TempDir(),
+		InsecureSkipVerify: true,
+	})
+	require.NoError(t, err)
+
+	clock := clockwork.NewFakeClock()
+	daemon, err := New(Config{
+		Storage:        storage,
+		Clock:          clock,
+		KubeconfigsDir: t.TempDir(),
+		AgentsDir:      t.TempDir(),
+	})
+	require.NoError(t, err)
+
+	const clusterURI = "/clusters/foo"
+	ha := &types.HeadlessAuthentication{ClientIpAddress: "1.2.3.4"}
+
+	// Without a registered action, prompts are unexpected.
+	require.False(t, daemon.isExpectedHeadlessAuthentication(clusterURI, ha))
+
+	// A recent action for the cluster marks prompts as expected.
+	daemon.RegisterHeadlessAction(clusterURI, "")
+	require.True(t, daemon.isExpectedHeadlessAuthentication(clusterURI, ha))
+	require.False(t, daemon.isExpectedHeadlessAuthentication("/clusters/bar", ha))
+
+	// An action with a recorded client IP only matches prompts from that IP.
+	daemon.RegisterHeadlessAction(clusterURI, "4.3.2.1")
+	require.False(t, daemon.isExpectedHeadlessAuthentication(clusterURI, ha))
+	daemon.RegisterHeadlessAction(clusterURI, "1.2.3.4")
+	require.True(t, daemon.isExpectedHeadlessAuthentication(clusterURI, ha))
+
+	// Actions older than the headless login timeout no longer match.
+	clock.Advance(defaults.HeadlessLoginTimeout + time.Second)
+	require.False(t, daemon.isExpectedHeadlessAuthentication(clusterURI, ha))
+}
+
+func TestRecentlyResolvedHeadless(t *testing.T) {
+	t.Parallel()
+
+	clock := clockwork.NewFakeClock()
+	recentlyResolved := newRecentlyResolvedHeadless(clock)
+
+	require.False(t, recentlyResolved.isRecentlyResolved("foo"))
+
+	recentlyResolved.add("foo")
+	require.True(t, recentlyResolved.isRecentlyResolved("foo"))
+	require.False(t, recentlyResolved.isRecentlyResolved("bar"))
+
+	// Entries past the suppression window no longer suppress prompts and are
+	// pruned on the next add.
+	clock.Advance(headlessResolutionSuppressionWindow + time.Second)
+	require.False(t, recentlyResolved.isRecentlyResolved("foo"))
+
+	recentlyResolved.add("bar")
+	require.True(t, recentlyResolved.isRecentlyResolved("bar"))
+	require.Len(t, recentlyResolved.resolved, 1)
+}
+
+func TestWatchWithRecover(t *testing.T) {
+	t.Parallel()
+
+	log := logrus.NewEntry(logrus.StandardLogger())
+
+	// A panic in the watch function is converted into an error so the watch
+	// loop restarts through the usual retry path.
+	err := watchWithRecover(log, func() error {
+		panic("unexpected bug in event handling")
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unexpected bug in event handling")
+
+	// Regular errors pass through untouched.
+	watchErr := trace.Errorf("watcher error")
+	err = watchWithRecover(log, func() error { return watchErr })
+	require.ErrorIs(t, err, watchErr)
+
+	require.NoError(t, watchWithRecover(log, func() error { return nil }))
+}
+
 func TestGetGatewayCLICommand(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,43 @@
+/*
+Copyright 2023 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package log
+
+import "context"
+
+// suppressLogsKey marks a context as carrying suppressed log records.
+type suppressLogsKey struct{}
+
+// WithLogSuppressed returns a context that causes the slog handlers in this
+// package to drop any records logged with it. It provides a defense-in-depth
+// switch for code regions handling sensitive material where nothing must be
+// logged, even accidentally, without restructuring logging call sites.
+//
+// Handlers check the context on every record, which costs a context value
+// lookup per Enabled/Handle call. The lookup is cheap, but callers on very hot
+// logging paths should be aware of it.
+func WithLogSuppressed(ctx context.Context) context.Context {
+	return context.WithValue(ctx, suppressLogsKey{}, true)
+}
+
+// isLogSuppressed reports whether records logged with ctx must be dropped.
+func isLogSuppressed(ctx context.Context) bool {
+	if ctx == nil {
+		return false
+	}
+	suppressed, _ := ctx.Value(suppressLogsKey{}).(bool)
+	return suppressed
+}
@@ -0,0 +1,217 @@
+/*
+Copyright 2023 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package log
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/gravitational/trace"
+)
+
+const (
+	// bufferedFlushInterval is the default interval at which buffered records
+	// are flushed to the wrapped handler.
+	bufferedFlushInterval = 5 * time.Second
+	// bufferedMaxRecords is the default number of records accumulated before
+	// the buffer is flushed regardless of the interval.
+	bufferedMaxRecords = 512
+)
+
+// bufferedRecord pairs a record with the handler clone that buffered it, so
+// attributes and groups added via WithAttrs and WithGroup are preserved when
+// the record is eventually handled.
+type bufferedRecord struct {
+	handler slog.Handler
+	record  slog.Record
+}
+
+// BufferedHandler is a [slog.Handler] that accumulates records and forwards
+// them to the wrapped handler in batches, trading delivery latency for fewer
+// writes on chatty processes. Records are flushed when the buffer fills, when
+// the flush interval elapses, and immediately for records at or above the
+// flush level so high-severity output is never held back. A forced flush
+// writes all preceding buffered records first, preserving ordering.
+type BufferedHandler struct {
+	inner slog.Handler
+
+	// flushInterval is the interval at which buffered records are flushed.
+	flushInterval time.Duration
+	// flushLevel is the level at or above which a record forces an immediate
+	// flush instead of waiting for the interval.
+	flushLevel slog.Level
+	// maxRecords is the buffer size above which a flush is forced.
+	maxRecords int
+
+	// mu protects batch. It is shared by all clones of the handler.
+	mu *sync.Mutex
+	// batch holds records that have not been handled yet.
+	batch *[]bufferedRecord
+
+	// done stops the periodic flush goroutine. It is shared by all clones.
+	done chan struct{}
+	// closeOnce ensures done is only closed once across all clones.
+	closeOnce *sync.Once
+}
+
+var _ ClosableHandler = (*BufferedHandler)(nil)
+
+// BufferedHandlerOption is a functional option for the buffered handler.
+type BufferedHandlerOption func(*BufferedHandler)
+
+// WithFlushInterval overrides the interval at which buffered records are
+// flushed. Non-positive intervals are ignored.
+func WithFlushInterval(interval time.Duration) BufferedHandlerOption {
+	return func(h *BufferedHandler) {
+		if interval > 0 {
+			h.flushInterval = interval
+		}
+	}
+}
+
+// WithFlushLevel overrides the level at or above which a record bypasses the
+// buffer and forces an immediate flush.
+func WithFlushLevel(level slog.Level) BufferedHandlerOption {
+	return func(h *BufferedHandler) {
+		h.flushLevel = level
+	}
+}
+
+// WithMaxBufferedRecords overrides the number of records accumulated before
+// the buffer is flushed regardless of the interval. Non-positive sizes are
+// ignored.
+func WithMaxBufferedRecords(size int) BufferedHandlerOption {
+	return func(h *BufferedHandler) {
+		if size > 0 {
+			h.maxRecords = size
+		}
+	}
+}
+
+// NewBufferedHandler creates a BufferedHandler wrapping the provided handler.
+// Call Close before process exit to flush remaining records and stop the
+// periodic flush goroutine.
+func NewBufferedHandler(inner slog.Handler, opts ...BufferedHandlerOption) *BufferedHandler {
+	h := &BufferedHandler{
+		inner:         inner,
+		flushInterval: bufferedFlushInterval,
+		flushLevel:    slog.LevelError,
+		maxRecords:    bufferedMaxRecords,
+		mu:            &sync.Mutex{},
+		batch:         &[]bufferedRecord{},
+		done:          make(chan struct{}),
+		closeOnce:     &sync.Once{},
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	go func() {
+		ticker := time.NewTicker(h.flushInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				_ = h.Flush(context.Background())
+			case <-h.done:
+				return
+			}
+		}
+	}()
+
+	return h
+}
+
+// Enabled returns whether the wrapped handler would handle a record at the
+// provided level.
+func (h *BufferedHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.inner.Enabled(ctx, level)
+}
+
+// Handle buffers the record. The buffer is flushed once it reaches the
+// maximum size or when a record at or above the flush level is handled.
+func (h *BufferedHandler) Handle(ctx context.Context, r slog.Record) error {
+	h.mu.Lock()
+	*h.batch = append(*h.batch, bufferedRecord{handler: h.inner, record: r.Clone()})
+	if len(*h.batch) < h.maxRecords && r.Level < h.flushLevel {
+		h.mu.Unlock()
+		return nil
+	}
+	batch := *h.batch
+	*h.batch = nil
+	h.mu.Unlock()
+
+	return trace.Wrap(handleBatch(ctx, batch))
+}
+
+// Flush hands any buffered records to the wrapped handler.
+func (h *BufferedHandler) Flush(ctx context.Context) error {
+	h.mu.Lock()
+	batch := *h.batch
+	*h.batch = nil
+	h.mu.Unlock()
+
+	return trace.Wrap(handleBatch(ctx, batch))
+}
+
+// handleBatch hands the records to the handlers that buffered them, in order.
+func handleBatch(ctx context.Context, batch []bufferedRecord) error {
+	var errors []error
+	for _, buffered := range batch {
+		if err := buffered.handler.Handle(ctx, buffered.record); err != nil {
+			errors = append(errors, err)
+		}
+	}
+	return trace.NewAggregate(errors...)
+}
+
+// Close flushes any remaining records and stops the periodic flush goroutine.
+func (h *BufferedHandler) Close() error {
+	h.closeOnce.Do(func() { close(h.done) })
+	return trace.Wrap(h.Flush(context.Background()))
+}
+
+// clone duplicates the handler. Clones share the batch, its mutex and the
+// flush goroutine.
+func (h *BufferedHandler) clone() *BufferedHandler {
+	clone := *h
+	return &clone
+}
+
+// WithAttrs returns a new handler whose records are handled by the wrapped
+// handler with the provided attributes.
+func (h *BufferedHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	if len(attrs) == 0 {
+		return h
+	}
+	clone := h.clone()
+	clone.inner = h.inner.WithAttrs(attrs)
+	return clone
+}
+
+// WithGroup returns a new handler whose records are handled by the wrapped
+// handler within the provided group.
+func (h *BufferedHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	clone := h.clone()
+	clone.inner = h.inner.WithGroup(name)
+	return clone
+}
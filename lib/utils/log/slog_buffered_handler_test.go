@@ -0,0 +1,121 @@
+/*
+Copyright 2023 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package log
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// syncBuffer is a bytes.Buffer safe for concurrent use, so tests can read
+// output while the periodic flush goroutine may be writing.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func TestBufferedHandlerFlushLevel(t *testing.T) {
+	var buf syncBuffer
+	handler := NewBufferedHandler(
+		NewSlogTextHandler(&buf, slog.LevelDebug, false, WithDeterministicOutput()),
+		WithFlushInterval(time.Hour),
+		WithFlushLevel(slog.LevelWarn),
+	)
+	defer handler.Close()
+	logger := slog.New(handler)
+
+	// Low-severity records sit in the buffer until the interval.
+	logger.Debug("first")
+	logger.Info("second")
+	require.Empty(t, buf.String())
+
+	// A record at the flush level forces an immediate flush, writing the
+	// preceding buffered records first.
+	logger.Warn("third")
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 3)
+	require.Contains(t, lines[0], "first")
+	require.Contains(t, lines[1], "second")
+	require.Contains(t, lines[2], "third")
+}
+
+func TestBufferedHandlerMaxRecords(t *testing.T) {
+	var buf syncBuffer
+	handler := NewBufferedHandler(
+		NewSlogTextHandler(&buf, slog.LevelDebug, false),
+		WithFlushInterval(time.Hour),
+		WithMaxBufferedRecords(2),
+	)
+	defer handler.Close()
+	logger := slog.New(handler)
+
+	logger.Info("first")
+	require.Empty(t, buf.String())
+	logger.Info("second")
+	require.Contains(t, buf.String(), "first")
+	require.Contains(t, buf.String(), "second")
+}
+
+func TestBufferedHandlerPeriodicFlush(t *testing.T) {
+	var buf syncBuffer
+	handler := NewBufferedHandler(
+		NewSlogTextHandler(&buf, slog.LevelDebug, false),
+		WithFlushInterval(10*time.Millisecond),
+	)
+	defer handler.Close()
+	logger := slog.New(handler)
+
+	logger.Info("hello world")
+	require.Eventually(t, func() bool {
+		return strings.Contains(buf.String(), "hello world")
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestBufferedHandlerClose(t *testing.T) {
+	var buf syncBuffer
+	handler := NewBufferedHandler(
+		NewSlogTextHandler(&buf, slog.LevelDebug, false),
+		WithFlushInterval(time.Hour),
+	)
+	logger := slog.New(handler)
+
+	logger.Info("hello world")
+	require.Empty(t, buf.String())
+	require.NoError(t, handler.Close())
+	require.Contains(t, buf.String(), "hello world")
+
+	// Closing again is a no-op.
+	require.NoError(t, handler.Close())
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2023 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package log provides slog handlers that emit messages in the formats
+// traditionally used by Teleport.
+package log
+
+import (
+	"fmt"
+	"log/slog"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+const (
+	// TraceLevel is the logging level below [slog.LevelDebug], matching the
+	// logrus trace level.
+	TraceLevel slog.Level = slog.LevelDebug - 1
+	// FatalLevel is the logging level above [slog.LevelError], matching the
+	// logrus fatal level.
+	FatalLevel slog.Level = slog.LevelError + 1
+)
+
+// ANSI color codes used when colored output is enabled.
+const (
+	noColor = -1
+	red     = 31
+	yellow  = 33
+	blue    = 36
+	gray    = 37
+)
+
+// ClosableHandler is implemented by handlers whose output path holds buffered
+// state. Callers owning the logging pipeline should close such handlers before
+// process exit so the final records reach their destination.
+type ClosableHandler interface {
+	slog.Handler
+	// Close flushes any buffered records and releases resources held by the
+	// handler. The handler must not be used after Close.
+	Close() error
+}
+
+// buffer adapts a byte slice for use as an output staging area by handlers.
+// Buffers are pooled to avoid allocating per record.
+type buffer []byte
+
+var bufPool = sync.Pool{
+	New: func() any {
+		b := make(buffer, 0, 1024)
+		return &b
+	},
+}
+
+func newBuffer() *buffer {
+	return bufPool.Get().(*buffer)
+}
+
+// Free returns the buffer to the pool, unless it grew too large to be worth
+// retaining.
+func (b *buffer) Free() {
+	const maxBufferSize = 16 << 10
+	if cap(*b) <= maxBufferSize {
+		*b = (*b)[:0]
+		bufPool.Put(b)
+	}
+}
+
+// levelText converts the provided level into its logrus string representation.
+func levelText(level slog.Level) string {
+	switch {
+	case level <= TraceLevel:
+		return "trace"
+	case level < slog.LevelInfo:
+		return "debug"
+	case level < slog.LevelWarn:
+		return "info"
+	case level < slog.LevelError:
+		return "warning"
+	case level < FatalLevel:
+		return "error"
+	default:
+		return "fatal"
+	}
+}
+
+// levelColor returns the ANSI color used for the provided level.
+func levelColor(level slog.Level) int {
+	switch {
+	case level < slog.LevelInfo:
+		return gray
+	case level < slog.LevelWarn:
+		return blue
+	case level < slog.LevelError:
+		return yellow
+	default:
+		return red
+	}
+}
+
+// getCaller returns the location that produced the record in the
+// form path/segment:<line number>, matching the logrus formatters.
+func getCaller(r slog.Record) string {
+	if r.PC == 0 {
+		return ""
+	}
+	frames := runtime.CallersFrames([]uintptr{r.PC})
+	frame, _ := frames.Next()
+	if frame.File == "" {
+		return ""
+	}
+	file := frame.File
+	// Keep the last two path segments for brevity.
+	if idx := strings.LastIndexByte(file, '/'); idx > 0 {
+		if idx = strings.LastIndexByte(file[:idx], '/'); idx >= 0 {
+			file = file[idx+1:]
+		}
+	}
+	return fmt.Sprintf("%s:%d", file, frame.Line)
+}
+
+// getCallerFunction returns the fully qualified name of the function that
+// produced the record.
+func getCallerFunction(r slog.Record) string {
+	if r.PC == 0 {
+		return ""
+	}
+	frames := runtime.CallersFrames([]uintptr{r.PC})
+	frame, _ := frames.Next()
+	return frame.Function
+}
+
+// callerFunction trims the package path of a fully qualified function name,
+// keeping pkgDepth trailing path segments, so e.g. a depth of 1 renders
+// github.com/gravitational/teleport/lib/utils/log.getCaller as log.getCaller.
+func callerFunction(function string, pkgDepth int) string {
+	if function == "" || pkgDepth <= 0 {
+		return function
+	}
+	segments := strings.Split(function, "/")
+	if pkgDepth < len(segments) {
+		segments = segments[len(segments)-pkgDepth:]
+	}
+	return strings.Join(segments, "/")
+}
+
+func padMax(in string, chars int) string {
+	switch {
+	case len(in) < chars:
+		return in + strings.Repeat(" ", chars-len(in))
+	default:
+		return in[:chars]
+	}
+}
+
+func needsQuoting(text string) bool {
+	for _, r := range text {
+		if r <= ' ' || r == '"' || r == '=' || r == 0x7f {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,328 @@
+/*
+Copyright 2023 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package log
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gravitational/trace"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlogTextHandlerOutput(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(NewSlogTextHandler(&buf, slog.LevelInfo, false))
+
+	logger.Info("hello world", "animal", "walrus")
+
+	out := buf.String()
+	require.Contains(t, out, "INFO")
+	require.Contains(t, out, "hello world")
+	require.Contains(t, out, "animal:walrus")
+
+	// Records below the handler level are dropped.
+	buf.Reset()
+	logger.Debug("too quiet")
+	require.Empty(t, buf.String())
+}
+
+func TestSlogTextHandlerForOutput(t *testing.T) {
+	// A plain buffer is not a terminal, so colors stay disabled.
+	var buf bytes.Buffer
+	logger := slog.New(NewSlogTextHandlerForOutput(&buf, slog.LevelInfo))
+	logger.Error("boom")
+	require.Contains(t, buf.String(), "boom")
+	require.NotContains(t, buf.String(), "\x1b[")
+
+	// Neither is a redirected file.
+	f, err := os.CreateTemp(t.TempDir(), "log")
+	require.NoError(t, err)
+	defer f.Close()
+	logger = slog.New(NewSlogTextHandlerForOutput(f, slog.LevelInfo))
+	logger.Error("boom")
+	out, err := os.ReadFile(f.Name())
+	require.NoError(t, err)
+	require.Contains(t, string(out), "boom")
+	require.NotContains(t, string(out), "\x1b[")
+}
+
+func TestSlogJSONHandlerOutput(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(NewSlogJSONHandler(&buf, slog.LevelInfo))
+
+	logger.Warn("something happened", "animal", "walrus")
+
+	out := buf.String()
+	require.Contains(t, out, `"level":"warning"`)
+	require.Contains(t, out, `"message":"something happened"`)
+	require.Contains(t, out, `"animal":"walrus"`)
+	require.Contains(t, out, `"caller":`)
+	require.Contains(t, out, `"timestamp":`)
+}
+
+func TestSlogHandlersDurationAndTimeAttrs(t *testing.T) {
+	deadline := time.Date(2023, 4, 5, 6, 7, 8, 0, time.UTC)
+
+	var text bytes.Buffer
+	slog.New(NewSlogTextHandler(&text, slog.LevelInfo, false)).
+		Info("hello world", "ttl", 90*time.Minute, "deadline", deadline)
+	require.Contains(t, text.String(), "ttl:1h30m0s")
+	require.Contains(t, text.String(), "deadline:2023-04-05T06:07:08Z")
+
+	// The JSON handler renders the same values identically.
+	var json bytes.Buffer
+	slog.New(NewSlogJSONHandler(&json, slog.LevelInfo)).
+		Info("hello world", "ttl", 90*time.Minute, "deadline", deadline)
+	require.Contains(t, json.String(), `"ttl":"1h30m0s"`)
+	require.Contains(t, json.String(), `"deadline":"2023-04-05T06:07:08Z"`)
+}
+
+func TestSlogHandlersCallerFunction(t *testing.T) {
+	// The default output keeps the plain file:line format.
+	var buf bytes.Buffer
+	slog.New(NewSlogTextHandler(&buf, slog.LevelInfo, false)).Info("hello world")
+	require.Contains(t, buf.String(), "log/slog_handler_test.go:")
+	require.NotContains(t, buf.String(), "TestSlogHandlersCallerFunction")
+
+	// With the option enabled the function name precedes the location, with
+	// its package path trimmed to the requested depth.
+	buf.Reset()
+	slog.New(NewSlogTextHandler(&buf, slog.LevelInfo, false, WithCallerFunction(1))).Info("hello world")
+	require.Contains(t, buf.String(), "log.TestSlogHandlersCallerFunction (log/slog_handler_test.go:")
+
+	buf.Reset()
+	slog.New(NewSlogTextHandler(&buf, slog.LevelInfo, false, WithCallerFunction(2))).Info("hello world")
+	require.Contains(t, buf.String(), "utils/log.TestSlogHandlersCallerFunction (log/slog_handler_test.go:")
+
+	// The JSON handler renders the same form in the caller field.
+	buf.Reset()
+	slog.New(NewSlogJSONHandler(&buf, slog.LevelInfo, WithJSONCallerFunction(1))).Info("hello world")
+	require.Contains(t, buf.String(), `"caller":"log.TestSlogHandlersCallerFunction (log/slog_handler_test.go:`)
+}
+
+func TestSlogTextHandlerHostnameAndPID(t *testing.T) {
+	hostname, err := os.Hostname()
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	logger := slog.New(NewSlogTextHandler(&buf, slog.LevelInfo, false, WithHostname(), WithPID()))
+
+	logger.Info("hello world")
+
+	out := buf.String()
+	require.Contains(t, out, "hostname:"+hostname)
+	require.Contains(t, out, fmt.Sprintf("pid:%d", os.Getpid()))
+
+	// The fields are independently toggleable.
+	buf.Reset()
+	logger = slog.New(NewSlogTextHandler(&buf, slog.LevelInfo, false, WithPID()))
+	logger.Info("hello world")
+	require.NotContains(t, buf.String(), "hostname:")
+	require.Contains(t, buf.String(), "pid:")
+}
+
+func TestSlogTextHandlerPadding(t *testing.T) {
+	render := func(opts ...SlogTextHandlerOption) string {
+		var buf bytes.Buffer
+		handler := NewSlogTextHandler(&buf, slog.LevelInfo, false, opts...)
+		logger := slog.New(handler.WithAttrs([]slog.Attr{slog.String(trace.Component, "longcomponent")}))
+		logger.Info("hello world")
+		return buf.String()
+	}
+
+	// Wider paddings extend both columns.
+	out := render(WithComponentPadding(20), WithLevelPadding(8))
+	require.Contains(t, out, "INFO     [LONGCOMPONENT]      hello world")
+
+	// Narrower paddings truncate, keeping the component bracketed.
+	out = render(WithComponentPadding(8), WithLevelPadding(3))
+	require.Contains(t, out, "INF [LONGCO] hello world")
+
+	// Non-positive widths keep the defaults.
+	require.Equal(t,
+		render(WithDeterministicOutput()),
+		render(WithDeterministicOutput(), WithComponentPadding(0), WithLevelPadding(-1)))
+}
+
+func BenchmarkSlogTextHandlerFixedAttrs(b *testing.B) {
+	logger := slog.New(NewSlogTextHandler(io.Discard, slog.LevelInfo, false, WithHostname(), WithPID()))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		logger.Info("hello world")
+	}
+}
+
+func TestSlogTextHandlerLargeRecord(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(NewSlogTextHandler(&buf, slog.LevelInfo, false))
+
+	// A record with attributes well beyond largeRecordThreshold is streamed
+	// to the output in chunks but still comes through intact and unbroken.
+	large := strings.Repeat("x", 3*largeRecordThreshold)
+	logger.Info("hello world", "large", large, "animal", "walrus")
+
+	out := buf.String()
+	require.Contains(t, out, "large:"+large)
+	require.Contains(t, out, "animal:walrus")
+	require.Equal(t, 1, strings.Count(out, "\n"))
+}
+
+func BenchmarkSlogTextHandlerLargeAttr(b *testing.B) {
+	logger := slog.New(NewSlogTextHandler(io.Discard, slog.LevelInfo, false))
+	large := strings.Repeat("x", 3*largeRecordThreshold)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		logger.Info("hello world", "large", large)
+	}
+}
+
+func TestSlogTextHandlerDeterministicOutput(t *testing.T) {
+	render := func() string {
+		var buf bytes.Buffer
+		logger := slog.New(NewSlogTextHandler(&buf, slog.LevelInfo, false, WithDeterministicOutput()))
+		logger.Info("hello world", "walrus", 1, "animal", "walrus")
+		return buf.String()
+	}
+
+	out := render()
+	require.Equal(t, "INFO hello world animal:walrus walrus:1 caller.go:0\n", out)
+
+	// Repeated runs produce identical output.
+	require.Equal(t, out, render())
+}
+
+// flakyWriter fails writes while broken is set.
+type flakyWriter struct {
+	bytes.Buffer
+	broken bool
+}
+
+func (w *flakyWriter) Write(p []byte) (int, error) {
+	if w.broken {
+		return 0, errors.New("broken pipe")
+	}
+	return w.Buffer.Write(p)
+}
+
+func TestSlogTextHandlerFallbackWriter(t *testing.T) {
+	primary := &flakyWriter{}
+	var fallback bytes.Buffer
+	handler := NewSlogTextHandler(primary, slog.LevelInfo, false, WithFallbackWriter(&fallback))
+	logger := slog.New(handler)
+
+	ctx := context.Background()
+
+	// While the primary writer fails, records land in the fallback and the
+	// write error is surfaced only once.
+	primary.broken = true
+	record := slog.NewRecord(time.Now(), slog.LevelInfo, "first", 0)
+	require.Error(t, handler.Handle(ctx, record))
+	record = slog.NewRecord(time.Now(), slog.LevelInfo, "second", 0)
+	require.NoError(t, handler.Handle(ctx, record))
+	require.Contains(t, fallback.String(), "first")
+	require.Contains(t, fallback.String(), "second")
+	require.Empty(t, primary.String())
+
+	// Once the primary writer recovers, records go to it again.
+	primary.broken = false
+	logger.Info("third")
+	require.Contains(t, primary.String(), "third")
+	require.NotContains(t, fallback.String(), "third")
+
+	// A new failure after recovery is surfaced again.
+	primary.broken = true
+	record = slog.NewRecord(time.Now(), slog.LevelInfo, "fourth", 0)
+	require.Error(t, handler.Handle(ctx, record))
+}
+
+func TestSlogTextHandlerFatalFlush(t *testing.T) {
+	var buf bytes.Buffer
+	writer := bufio.NewWriterSize(&buf, 4096)
+	logger := slog.New(NewSlogTextHandler(writer, slog.LevelInfo, false))
+
+	// Records below fatal stay in the buffered writer.
+	logger.Error("disk is full")
+	require.Empty(t, buf.String())
+
+	// A fatal record flushes everything written so far.
+	logger.Log(context.Background(), FatalLevel, "giving up")
+	require.Contains(t, buf.String(), "disk is full")
+	require.Contains(t, buf.String(), "FATA")
+	require.Contains(t, buf.String(), "giving up")
+}
+
+func TestSlogTextHandlerClose(t *testing.T) {
+	var buf bytes.Buffer
+	bw := bufio.NewWriterSize(&buf, 4096)
+	handler := NewSlogTextHandler(bw, slog.LevelInfo, false)
+	logger := slog.New(handler)
+
+	logger.Info("first")
+	logger.Info("second")
+
+	// The records may still sit in the bufio buffer; Close must flush them and
+	// leave a well-formed, newline-terminated final record.
+	require.NoError(t, handler.Close())
+	out := buf.String()
+	require.True(t, strings.HasSuffix(out, "\n"))
+	lines := strings.Split(strings.TrimSuffix(out, "\n"), "\n")
+	require.Len(t, lines, 2)
+	require.Contains(t, lines[0], "first")
+	require.Contains(t, lines[1], "second")
+}
+
+func TestWithLogSuppressed(t *testing.T) {
+	ctx := context.Background()
+	suppressedCtx := WithLogSuppressed(ctx)
+
+	handlers := map[string]func(*bytes.Buffer) slog.Handler{
+		"text": func(buf *bytes.Buffer) slog.Handler {
+			return NewSlogTextHandler(buf, slog.LevelInfo, false)
+		},
+		"json": func(buf *bytes.Buffer) slog.Handler {
+			return NewSlogJSONHandler(buf, slog.LevelInfo)
+		},
+	}
+
+	for name, newHandler := range handlers {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+			handler := newHandler(&buf)
+			logger := slog.New(handler)
+
+			require.False(t, handler.Enabled(suppressedCtx, slog.LevelError))
+
+			logger.ErrorContext(suppressedCtx, "secret", "token", "hunter2")
+			require.Empty(t, buf.String())
+
+			logger.ErrorContext(ctx, "not a secret")
+			require.Contains(t, buf.String(), "not a secret")
+		})
+	}
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2023 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package log
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/gravitational/trace"
+)
+
+const (
+	levelField     = "level"
+	componentField = "component"
+	callerField    = "caller"
+	timestampField = "timestamp"
+	messageField   = "message"
+)
+
+// SlogJSONHandler is a [slog.Handler] that outputs messages in a JSON
+// format matching the output of the logrus JSON formatter used by Teleport.
+type SlogJSONHandler struct {
+	*slog.JSONHandler
+
+	// callerFunctionDepth, when positive, includes the function name in the
+	// caller field with its package path trimmed to that many segments.
+	callerFunctionDepth int
+}
+
+// SlogJSONHandlerOption customizes a SlogJSONHandler.
+type SlogJSONHandlerOption func(*SlogJSONHandler)
+
+// NewSlogJSONHandler creates a SlogJSONHandler that writes messages to w.
+func NewSlogJSONHandler(w io.Writer, level slog.Leveler, opts ...SlogJSONHandlerOption) *SlogJSONHandler {
+	j := &SlogJSONHandler{}
+	for _, opt := range opts {
+		opt(j)
+	}
+	j.JSONHandler = slog.NewJSONHandler(w, &slog.HandlerOptions{
+		AddSource:   true,
+		Level:       level,
+		ReplaceAttr: j.jsonReplaceAttr,
+	})
+	return j
+}
+
+// jsonReplaceAttr renames the built-in slog record fields to match those
+// emitted by the logrus JSON formatter.
+func (j *SlogJSONHandler) jsonReplaceAttr(groups []string, a slog.Attr) slog.Attr {
+	if len(groups) > 0 {
+		return a
+	}
+	// Render durations compactly and timestamps in the same format as the
+	// text handler so attribute values agree between the two.
+	switch a.Value.Kind() {
+	case slog.KindDuration:
+		a.Value = slog.StringValue(a.Value.Duration().String())
+	case slog.KindTime:
+		a.Value = slog.StringValue(a.Value.Time().Format(time.RFC3339))
+	}
+	switch a.Key {
+	case slog.TimeKey:
+		a.Key = timestampField
+	case slog.LevelKey:
+		if level, ok := a.Value.Any().(slog.Level); ok {
+			a.Value = slog.StringValue(levelText(level))
+		}
+		a.Key = levelField
+	case slog.MessageKey:
+		a.Key = messageField
+	case slog.SourceKey:
+		a.Key = callerField
+		if source, ok := a.Value.Any().(*slog.Source); ok {
+			file := source.File
+			if idx := strings.LastIndexByte(file, '/'); idx > 0 {
+				if idx = strings.LastIndexByte(file[:idx], '/'); idx >= 0 {
+					file = file[idx+1:]
+				}
+			}
+			caller := fmt.Sprintf("%s:%d", file, source.Line)
+			if j.callerFunctionDepth > 0 && source.Function != "" {
+				caller = fmt.Sprintf("%s (%s)", callerFunction(source.Function, j.callerFunctionDepth), caller)
+			}
+			a.Value = slog.StringValue(caller)
+		}
+	case trace.Component:
+		a.Key = componentField
+	}
+	return a
+}
+
+// Enabled returns whether the provided level will be included in output.
+// Note that records logged via a context produced by [WithLogSuppressed] are
+// never enabled, which requires a context value lookup per call.
+func (j *SlogJSONHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	if isLogSuppressed(ctx) {
+		return false
+	}
+	return j.JSONHandler.Enabled(ctx, level)
+}
+
+// Handle formats the record and writes the output to the destination.
+func (j *SlogJSONHandler) Handle(ctx context.Context, r slog.Record) error {
+	if isLogSuppressed(ctx) {
+		return nil
+	}
+	return trace.Wrap(j.JSONHandler.Handle(ctx, r))
+}
+
+// WithAttrs returns a new handler whose attributes consist of both the
+// receiver's attributes and the arguments.
+func (j *SlogJSONHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &SlogJSONHandler{
+		JSONHandler:         j.JSONHandler.WithAttrs(attrs).(*slog.JSONHandler),
+		callerFunctionDepth: j.callerFunctionDepth,
+	}
+}
+
+// WithGroup returns a new handler that scopes all attributes under the
+// provided group name.
+func (j *SlogJSONHandler) WithGroup(name string) slog.Handler {
+	return &SlogJSONHandler{
+		JSONHandler:         j.JSONHandler.WithGroup(name).(*slog.JSONHandler),
+		callerFunctionDepth: j.callerFunctionDepth,
+	}
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2023 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package log
+
+import (
+	"io"
+	"log/slog"
+	"os"
+
+	"github.com/gravitational/trace"
+)
+
+const (
+	// FormatText renders records as human-readable text via
+	// [SlogTextHandler].
+	FormatText = "text"
+	// FormatJSON renders records as machine-readable JSON via
+	// [SlogJSONHandler].
+	FormatJSON = "json"
+)
+
+// LoggerConfig describes the logger built by NewLogger.
+type LoggerConfig struct {
+	// Output is the writer records are written to. Defaults to [os.Stderr].
+	Output io.Writer
+	// Format is the output format, [FormatText] or [FormatJSON]. Defaults to
+	// [FormatText].
+	Format string
+	// Level is the minimum level a record must have to be emitted. Defaults
+	// to [slog.LevelInfo].
+	Level slog.Leveler
+	// EnableColors enables ANSI colors in text output. It has no effect on
+	// JSON output.
+	EnableColors bool
+	// CallerFunctionDepth, when positive, includes the function name in
+	// caller output in the pkg.Func (file:line) form of [WithCallerFunction],
+	// keeping the given number of package path segments.
+	CallerFunctionDepth int
+	// TextOptions are additional handler options applied when Format is
+	// [FormatText].
+	TextOptions []SlogTextHandlerOption
+	// JSONOptions are additional handler options applied when Format is
+	// [FormatJSON].
+	JSONOptions []SlogJSONHandlerOption
+}
+
+// NewLogger builds a [slog.Logger] wired with the handler the config calls
+// for. It is the canonical way to construct a Teleport logger, so call sites
+// don't each assemble the handler, level and options by hand.
+func NewLogger(cfg LoggerConfig) (*slog.Logger, error) {
+	output := cfg.Output
+	if output == nil {
+		output = os.Stderr
+	}
+	level := cfg.Level
+	if level == nil {
+		level = slog.LevelInfo
+	}
+	format := cfg.Format
+	if format == "" {
+		format = FormatText
+	}
+
+	switch format {
+	case FormatText:
+		opts := cfg.TextOptions
+		if cfg.CallerFunctionDepth > 0 {
+			opts = append([]SlogTextHandlerOption{WithCallerFunction(cfg.CallerFunctionDepth)}, opts...)
+		}
+		return slog.New(NewSlogTextHandler(output, level, cfg.EnableColors, opts...)), nil
+	case FormatJSON:
+		opts := cfg.JSONOptions
+		if cfg.CallerFunctionDepth > 0 {
+			opts = append([]SlogJSONHandlerOption{WithJSONCallerFunction(cfg.CallerFunctionDepth)}, opts...)
+		}
+		return slog.New(NewSlogJSONHandler(output, level, opts...)), nil
+	default:
+		return nil, trace.BadParameter("unsupported log format %q, expected %q or %q", cfg.Format, FormatText, FormatJSON)
+	}
+}
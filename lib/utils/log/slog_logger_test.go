@@ -0,0 +1,83 @@
+/*
+Copyright 2023 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package log
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/gravitational/trace"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewLogger(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("text by default with info level", func(t *testing.T) {
+		var buf bytes.Buffer
+		logger, err := NewLogger(LoggerConfig{
+			Output:      &buf,
+			TextOptions: []SlogTextHandlerOption{WithDeterministicOutput()},
+		})
+		require.NoError(t, err)
+
+		logger.DebugContext(ctx, "filtered out")
+		logger.InfoContext(ctx, "emitted")
+
+		out := buf.String()
+		require.NotContains(t, out, "filtered out")
+		require.Contains(t, out, "emitted")
+	})
+
+	t.Run("json format honors the configured level", func(t *testing.T) {
+		var buf bytes.Buffer
+		logger, err := NewLogger(LoggerConfig{
+			Output: &buf,
+			Format: FormatJSON,
+			Level:  slog.LevelDebug,
+		})
+		require.NoError(t, err)
+
+		logger.DebugContext(ctx, "debug record")
+
+		var record map[string]any
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+		require.Equal(t, "debug record", record[messageField])
+		require.Equal(t, "debug", record[levelField])
+	})
+
+	t.Run("caller function depth applies to both formats", func(t *testing.T) {
+		var buf bytes.Buffer
+		logger, err := NewLogger(LoggerConfig{
+			Output:              &buf,
+			Format:              FormatJSON,
+			CallerFunctionDepth: 1,
+		})
+		require.NoError(t, err)
+
+		logger.InfoContext(ctx, "with function")
+		require.Contains(t, buf.String(), `"caller":"log.TestNewLogger`)
+	})
+
+	t.Run("unsupported format", func(t *testing.T) {
+		_, err := NewLogger(LoggerConfig{Format: "yaml"})
+		require.True(t, trace.IsBadParameter(err))
+	})
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2023 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package log
+
+import (
+	"io"
+	"log/slog"
+	"os"
+)
+
+const (
+	hostnameField = "hostname"
+	pidField      = "pid"
+)
+
+// WithHostname returns an option that includes the machine's hostname as a
+// fixed attribute on every record. The hostname is computed and rendered once
+// at construction, not per record.
+func WithHostname() SlogTextHandlerOption {
+	return func(s *SlogTextHandler) {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return
+		}
+		s.preformatted = s.appendAttr(s.preformatted, slog.String(hostnameField, hostname), "")
+	}
+}
+
+// WithDeterministicOutput returns an option that renders records in a fully
+// deterministic way, suitable for golden-file comparison in tests: timestamps
+// are omitted, the caller is fixed to a placeholder and attributes are sorted
+// by key instead of rendered in insertion order.
+func WithDeterministicOutput() SlogTextHandlerOption {
+	return func(s *SlogTextHandler) {
+		s.withTimestamp = false
+		s.deterministic = true
+	}
+}
+
+// WithFallbackWriter returns an option that routes records to w whenever a
+// write to the primary output fails, e.g. on a broken pipe. Writes to the
+// primary output are still attempted for every record, so the handler resumes
+// using it as soon as it recovers. Repeated identical write failures are
+// surfaced only once to keep the logging pipeline from flooding with its own
+// errors.
+func WithFallbackWriter(w io.Writer) SlogTextHandlerOption {
+	return func(s *SlogTextHandler) {
+		s.fallback = w
+	}
+}
+
+// WithComponentPadding returns an option that sets the width the bracketed
+// component is padded or truncated to for column alignment, for deployments
+// whose component names do not fit [trace.DefaultComponentPadding]. Components
+// longer than the width are truncated and re-closed with a bracket.
+// Non-positive widths are ignored.
+func WithComponentPadding(width int) SlogTextHandlerOption {
+	return func(s *SlogTextHandler) {
+		if width > 0 {
+			s.componentPadding = width
+		}
+	}
+}
+
+// WithLevelPadding returns an option that sets the width the level is padded
+// or truncated to for column alignment, defaulting to
+// [trace.DefaultLevelPadding]. Non-positive widths are ignored.
+func WithLevelPadding(width int) SlogTextHandlerOption {
+	return func(s *SlogTextHandler) {
+		if width > 0 {
+			s.levelPadding = width
+		}
+	}
+}
+
+// WithCallerFunction returns an option that includes the function name in
+// caller output in the form pkg.Func (file:line), for navigating codebases
+// where the same filename appears in multiple packages. pkgDepth controls how
+// many package path segments are kept, so a depth of 1 renders
+// github.com/gravitational/teleport/lib/utils/log.getCaller as log.getCaller.
+func WithCallerFunction(pkgDepth int) SlogTextHandlerOption {
+	return func(s *SlogTextHandler) {
+		if pkgDepth > 0 {
+			s.callerFunctionDepth = pkgDepth
+		}
+	}
+}
+
+// WithJSONCallerFunction returns an option that includes the function name in
+// the caller field of JSON output, in the same pkg.Func (file:line) form as
+// [WithCallerFunction].
+func WithJSONCallerFunction(pkgDepth int) SlogJSONHandlerOption {
+	return func(j *SlogJSONHandler) {
+		if pkgDepth > 0 {
+			j.callerFunctionDepth = pkgDepth
+		}
+	}
+}
+
+// WithPID returns an option that includes the process ID as a fixed attribute
+// on every record. The PID is computed and rendered once at construction, not
+// per record.
+func WithPID() SlogTextHandlerOption {
+	return func(s *SlogTextHandler) {
+		s.preformatted = s.appendAttr(s.preformatted, slog.Int(pidField, os.Getpid()), "")
+	}
+}
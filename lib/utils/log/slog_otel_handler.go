@@ -0,0 +1,257 @@
+/*
+Copyright 2023 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package log
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	"github.com/gravitational/trace"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+)
+
+// otelBatchSize is the number of log records accumulated before the batch is
+// exported. Records at error level or above flush the batch immediately.
+const otelBatchSize = 512
+
+// Attribute keys used for the record metadata emitted by OTelHandler.
+const (
+	otelComponentKey = "component"
+	otelCallerKey    = "caller"
+)
+
+// OTelExporter exports OTLP log records. It matches the exporter surface of
+// the OpenTelemetry log SDK so implementations can forward records to any
+// OTLP-compatible pipeline.
+type OTelExporter interface {
+	// Export exports a batch of log records.
+	Export(ctx context.Context, records []*logspb.LogRecord) error
+	// Shutdown flushes any buffered records and releases resources.
+	Shutdown(ctx context.Context) error
+}
+
+// OTelHandler is a [slog.Handler] that converts records into OTLP log records
+// and forwards them to an exporter in batches, so Teleport logs can share an
+// OpenTelemetry pipeline with metrics and traces.
+type OTelHandler struct {
+	level    slog.Leveler
+	exporter OTelExporter
+
+	// attrs is the data from attributes provided to WithAttrs, converted once
+	// instead of per record.
+	attrs []*commonpb.KeyValue
+	// groupPrefix is the dot-separated concatenation of group names provided
+	// to WithGroup, prepended to attribute keys.
+	groupPrefix string
+
+	// mu protects batch. It is shared by all clones of the handler.
+	mu *sync.Mutex
+	// batch holds records that have not been exported yet.
+	batch *[]*logspb.LogRecord
+}
+
+// NewOTelHandler creates an OTelHandler that exports records via the provided
+// exporter. Call Flush or Shutdown before process exit to avoid losing
+// buffered records.
+func NewOTelHandler(exporter OTelExporter, level slog.Leveler) *OTelHandler {
+	return &OTelHandler{
+		level:    level,
+		exporter: exporter,
+		mu:       &sync.Mutex{},
+		batch:    &[]*logspb.LogRecord{},
+	}
+}
+
+// Enabled returns whether the provided level will be exported.
+// Records logged via a context produced by [WithLogSuppressed] are never
+// enabled.
+func (h *OTelHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	if isLogSuppressed(ctx) {
+		return false
+	}
+	minimumLevel := slog.LevelInfo
+	if h.level != nil {
+		minimumLevel = h.level.Level()
+	}
+	return level >= minimumLevel
+}
+
+// otelSeverity converts the level into the matching OTLP severity number.
+func otelSeverity(level slog.Level) logspb.SeverityNumber {
+	switch {
+	case level <= TraceLevel:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_TRACE
+	case level < slog.LevelInfo:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_DEBUG
+	case level < slog.LevelWarn:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_INFO
+	case level < slog.LevelError:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_WARN
+	case level < FatalLevel:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_ERROR
+	default:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_FATAL
+	}
+}
+
+// otelValue converts an attribute value into the matching OTLP value.
+func otelValue(value slog.Value) *commonpb.AnyValue {
+	switch value.Kind() {
+	case slog.KindString:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: value.String()}}
+	case slog.KindBool:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_BoolValue{BoolValue: value.Bool()}}
+	case slog.KindInt64:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: value.Int64()}}
+	case slog.KindUint64:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: int64(value.Uint64())}}
+	case slog.KindFloat64:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_DoubleValue{DoubleValue: value.Float64()}}
+	default:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: fmt.Sprintf("%v", value.Any())}}
+	}
+}
+
+// appendOTelAttr converts the attribute and appends it to attrs. Group
+// attributes are flattened with a dot-separated key prefix.
+func appendOTelAttr(attrs []*commonpb.KeyValue, a slog.Attr, groupPrefix string) []*commonpb.KeyValue {
+	a.Value = a.Value.Resolve()
+	if a.Equal(slog.Attr{}) {
+		return attrs
+	}
+
+	if a.Value.Kind() == slog.KindGroup {
+		if a.Key != "" {
+			groupPrefix = groupPrefix + a.Key + "."
+		}
+		for _, attr := range a.Value.Group() {
+			attrs = appendOTelAttr(attrs, attr, groupPrefix)
+		}
+		return attrs
+	}
+
+	return append(attrs, &commonpb.KeyValue{
+		Key:   groupPrefix + a.Key,
+		Value: otelValue(a.Value),
+	})
+}
+
+// Handle converts the record into an OTLP log record and adds it to the
+// current batch. The batch is exported once it reaches otelBatchSize or
+// when a record at error level or above is handled.
+func (h *OTelHandler) Handle(ctx context.Context, r slog.Record) error {
+	if isLogSuppressed(ctx) {
+		return nil
+	}
+
+	attrs := make([]*commonpb.KeyValue, 0, len(h.attrs)+r.NumAttrs()+1)
+	attrs = append(attrs, h.attrs...)
+	r.Attrs(func(a slog.Attr) bool {
+		attrs = appendOTelAttr(attrs, a, h.groupPrefix)
+		return true
+	})
+	if caller := getCaller(r); caller != "" {
+		attrs = append(attrs, &commonpb.KeyValue{
+			Key:   otelCallerKey,
+			Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: caller}},
+		})
+	}
+
+	record := &logspb.LogRecord{
+		TimeUnixNano:   uint64(r.Time.UnixNano()),
+		SeverityNumber: otelSeverity(r.Level),
+		SeverityText:   levelText(r.Level),
+		Body:           &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: r.Message}},
+		Attributes:     attrs,
+	}
+
+	h.mu.Lock()
+	*h.batch = append(*h.batch, record)
+	if len(*h.batch) < otelBatchSize && r.Level < slog.LevelError {
+		h.mu.Unlock()
+		return nil
+	}
+	batch := *h.batch
+	*h.batch = nil
+	h.mu.Unlock()
+
+	return trace.Wrap(h.exporter.Export(ctx, batch))
+}
+
+// Flush exports any buffered records.
+func (h *OTelHandler) Flush(ctx context.Context) error {
+	h.mu.Lock()
+	batch := *h.batch
+	*h.batch = nil
+	h.mu.Unlock()
+
+	if len(batch) == 0 {
+		return nil
+	}
+	return trace.Wrap(h.exporter.Export(ctx, batch))
+}
+
+// Shutdown exports any buffered records and shuts the exporter down.
+func (h *OTelHandler) Shutdown(ctx context.Context) error {
+	err := h.Flush(ctx)
+	return trace.NewAggregate(err, h.exporter.Shutdown(ctx))
+}
+
+// clone duplicates the handler. Clones share the exporter, the batch and its
+// mutex.
+func (h *OTelHandler) clone() *OTelHandler {
+	clone := *h
+	return &clone
+}
+
+// WithAttrs returns a new handler whose attributes consist of both the
+// receiver's attributes and the arguments. Attributes are converted once here
+// instead of per record.
+func (h *OTelHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	if len(attrs) == 0 {
+		return h
+	}
+	clone := h.clone()
+	converted := make([]*commonpb.KeyValue, len(h.attrs), len(h.attrs)+len(attrs))
+	copy(converted, h.attrs)
+	for _, a := range attrs {
+		if a.Key == trace.Component && h.groupPrefix == "" {
+			converted = append(converted, &commonpb.KeyValue{
+				Key:   otelComponentKey,
+				Value: otelValue(a.Value.Resolve()),
+			})
+			continue
+		}
+		converted = appendOTelAttr(converted, a, h.groupPrefix)
+	}
+	clone.attrs = converted
+	return clone
+}
+
+// WithGroup returns a new handler that prefixes all attribute keys with the
+// provided group name.
+func (h *OTelHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	clone := h.clone()
+	clone.groupPrefix = h.groupPrefix + name + "."
+	return clone
+}
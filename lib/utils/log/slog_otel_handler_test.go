@@ -0,0 +1,113 @@
+/*
+Copyright 2023 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package log
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+)
+
+// fakeOTelExporter collects exported log records in memory.
+type fakeOTelExporter struct {
+	records  []*logspb.LogRecord
+	exports  int
+	shutdown bool
+}
+
+func (e *fakeOTelExporter) Export(ctx context.Context, records []*logspb.LogRecord) error {
+	e.records = append(e.records, records...)
+	e.exports++
+	return nil
+}
+
+func (e *fakeOTelExporter) Shutdown(ctx context.Context) error {
+	e.shutdown = true
+	return nil
+}
+
+func otelAttr(record *logspb.LogRecord, key string) string {
+	for _, attr := range record.Attributes {
+		if attr.Key == key {
+			return attr.Value.GetStringValue()
+		}
+	}
+	return ""
+}
+
+func TestOTelHandlerRecordConversion(t *testing.T) {
+	exporter := &fakeOTelExporter{}
+	handler := NewOTelHandler(exporter, slog.LevelInfo)
+	logger := slog.New(handler.WithAttrs([]slog.Attr{slog.String("cluster", "someCluster")}))
+
+	logger.Warn("something happened", "animal", "walrus", slog.Int("count", 3))
+	require.NoError(t, handler.Flush(context.Background()))
+
+	require.Len(t, exporter.records, 1)
+	record := exporter.records[0]
+	require.Equal(t, logspb.SeverityNumber_SEVERITY_NUMBER_WARN, record.SeverityNumber)
+	require.Equal(t, "warning", record.SeverityText)
+	require.Equal(t, "something happened", record.Body.GetStringValue())
+	require.NotZero(t, record.TimeUnixNano)
+	require.Equal(t, "someCluster", otelAttr(record, "cluster"))
+	require.Equal(t, "walrus", otelAttr(record, "animal"))
+	require.Contains(t, otelAttr(record, "caller"), ".go:")
+
+	var count int64
+	for _, attr := range record.Attributes {
+		if attr.Key == "count" {
+			count = attr.Value.GetIntValue()
+		}
+	}
+	require.EqualValues(t, 3, count)
+}
+
+func TestOTelHandlerBatching(t *testing.T) {
+	exporter := &fakeOTelExporter{}
+	handler := NewOTelHandler(exporter, slog.LevelInfo)
+	logger := slog.New(handler)
+
+	// Records below error level accumulate without exporting.
+	logger.Info("hello world")
+	logger.Info("hello again")
+	require.Zero(t, exporter.exports)
+
+	// An error level record flushes the whole batch.
+	logger.Error("boom")
+	require.Equal(t, 1, exporter.exports)
+	require.Len(t, exporter.records, 3)
+
+	// Shutdown exports buffered records and shuts the exporter down.
+	logger.Info("one more")
+	require.NoError(t, handler.Shutdown(context.Background()))
+	require.Len(t, exporter.records, 4)
+	require.True(t, exporter.shutdown)
+}
+
+func TestOTelHandlerWithGroup(t *testing.T) {
+	exporter := &fakeOTelExporter{}
+	handler := NewOTelHandler(exporter, slog.LevelInfo)
+	logger := slog.New(handler.WithGroup("request"))
+
+	logger.Error("boom", "id", "someRequestID")
+
+	require.Len(t, exporter.records, 1)
+	require.Equal(t, "someRequestID", otelAttr(exporter.records[0], "request.id"))
+}
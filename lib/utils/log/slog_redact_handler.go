@@ -0,0 +1,123 @@
+/*
+Copyright 2023 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package log
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/gravitational/trace"
+)
+
+// RedactHandler is a [slog.Handler] that replaces occurrences of configured
+// secret substrings with "***" in the message and string attribute values
+// before handing records to the wrapped handler. It is a last-line defense
+// against secrets embedded in log output, not a substitute for keeping them
+// out of logs in the first place.
+type RedactHandler struct {
+	inner slog.Handler
+	// replacer replaces every configured secret with the redaction marker.
+	// It is nil when no secrets are configured, in which case records pass
+	// through unscanned.
+	replacer *strings.Replacer
+}
+
+var _ slog.Handler = (*RedactHandler)(nil)
+
+// redactionMarker replaces configured secrets in redacted output.
+const redactionMarker = "***"
+
+// NewRedactHandler creates a RedactHandler that replaces occurrences of the
+// provided secrets with "***" before records reach the wrapped handler. Empty
+// secrets are ignored.
+func NewRedactHandler(inner slog.Handler, secrets []string) *RedactHandler {
+	var pairs []string
+	for _, secret := range secrets {
+		if secret == "" {
+			continue
+		}
+		pairs = append(pairs, secret, redactionMarker)
+	}
+
+	h := &RedactHandler{inner: inner}
+	if len(pairs) > 0 {
+		h.replacer = strings.NewReplacer(pairs...)
+	}
+	return h
+}
+
+// Enabled returns whether the wrapped handler would handle a record at the
+// provided level.
+func (h *RedactHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.inner.Enabled(ctx, level)
+}
+
+// Handle redacts the record and hands it to the wrapped handler.
+func (h *RedactHandler) Handle(ctx context.Context, r slog.Record) error {
+	if h.replacer == nil {
+		return trace.Wrap(h.inner.Handle(ctx, r))
+	}
+
+	redacted := slog.NewRecord(r.Time, r.Level, h.replacer.Replace(r.Message), r.PC)
+	r.Attrs(func(attr slog.Attr) bool {
+		redacted.AddAttrs(h.redactAttr(attr))
+		return true
+	})
+	return trace.Wrap(h.inner.Handle(ctx, redacted))
+}
+
+// redactAttr redacts string attribute values, descending into groups.
+func (h *RedactHandler) redactAttr(attr slog.Attr) slog.Attr {
+	switch attr.Value.Kind() {
+	case slog.KindString:
+		attr.Value = slog.StringValue(h.replacer.Replace(attr.Value.String()))
+	case slog.KindGroup:
+		group := attr.Value.Group()
+		redacted := make([]slog.Attr, 0, len(group))
+		for _, member := range group {
+			redacted = append(redacted, h.redactAttr(member))
+		}
+		attr.Value = slog.GroupValue(redacted...)
+	}
+	return attr
+}
+
+// WithAttrs returns a new handler with the provided attributes, redacted, set
+// on the wrapped handler.
+func (h *RedactHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	if len(attrs) == 0 {
+		return h
+	}
+	if h.replacer != nil {
+		redacted := make([]slog.Attr, 0, len(attrs))
+		for _, attr := range attrs {
+			redacted = append(redacted, h.redactAttr(attr))
+		}
+		attrs = redacted
+	}
+	return &RedactHandler{inner: h.inner.WithAttrs(attrs), replacer: h.replacer}
+}
+
+// WithGroup returns a new handler with the provided group opened on the
+// wrapped handler.
+func (h *RedactHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	return &RedactHandler{inner: h.inner.WithGroup(name), replacer: h.replacer}
+}
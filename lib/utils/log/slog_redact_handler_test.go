@@ -0,0 +1,77 @@
+/*
+Copyright 2023 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package log
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedactHandler(t *testing.T) {
+	newLogger := func(secrets []string) (*slog.Logger, *bytes.Buffer) {
+		var buf bytes.Buffer
+		inner := slog.NewTextHandler(&buf, &slog.HandlerOptions{
+			ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+				if a.Key == slog.TimeKey && len(groups) == 0 {
+					return slog.Attr{}
+				}
+				return a
+			},
+		})
+		return slog.New(NewRedactHandler(inner, secrets)), &buf
+	}
+
+	t.Run("redacts message and string attributes", func(t *testing.T) {
+		logger, buf := newLogger([]string{"hunter2", "s3cret"})
+		logger.InfoContext(context.Background(), "failed to use token hunter2",
+			"token", "hunter2",
+			"detail", "password s3cret rejected",
+			"count", 7,
+		)
+
+		out := buf.String()
+		require.NotContains(t, out, "hunter2")
+		require.NotContains(t, out, "s3cret")
+		require.Contains(t, out, "failed to use token ***")
+		require.Contains(t, out, "token=***")
+		require.Contains(t, out, `detail="password *** rejected"`)
+		require.Contains(t, out, "count=7")
+	})
+
+	t.Run("redacts grouped and preset attributes", func(t *testing.T) {
+		logger, buf := newLogger([]string{"hunter2"})
+		logger = logger.With("api_key", "hunter2")
+		logger.InfoContext(context.Background(), "request failed",
+			slog.Group("request", slog.String("header", "Bearer hunter2")),
+		)
+
+		out := buf.String()
+		require.NotContains(t, out, "hunter2")
+		require.Contains(t, out, "api_key=***")
+		require.Contains(t, out, `request.header="Bearer ***"`)
+	})
+
+	t.Run("passes records through when no secrets configured", func(t *testing.T) {
+		logger, buf := newLogger(nil)
+		logger.InfoContext(context.Background(), "nothing to hide", "token", "hunter2")
+		require.Contains(t, buf.String(), "token=hunter2")
+	})
+}
@@ -0,0 +1,430 @@
+/*
+Copyright 2023 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package log
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gravitational/trace"
+)
+
+// deterministicCaller is the caller placeholder emitted in deterministic
+// output mode.
+const deterministicCaller = "caller.go:0"
+
+// largeRecordThreshold is the buffer size above which a record is streamed to
+// the output in chunks instead of being accumulated in the pooled buffer.
+// It matches the maximum buffer size retained by the pool, so the pooled
+// fast path stays untouched for records small enough to be pooled.
+const largeRecordThreshold = 16 << 10
+
+// SlogTextHandler is a [slog.Handler] that outputs messages in a textual
+// format matching the output of the logrus text formatter used by Teleport.
+type SlogTextHandler struct {
+	level        slog.Leveler
+	enableColors bool
+	// withTimestamp indicates whether the record timestamp is emitted.
+	withTimestamp bool
+	// withCaller indicates whether the record source location is emitted.
+	withCaller bool
+	// callerFunctionDepth, when positive, includes the function name in caller
+	// output with its package path trimmed to that many segments.
+	callerFunctionDepth int
+	// deterministic indicates whether output is rendered for golden-file
+	// comparison: no timestamps, a fixed caller placeholder and attributes
+	// sorted by key instead of insertion order.
+	deterministic bool
+	// componentPadding is the width the bracketed component is padded or
+	// truncated to for column alignment.
+	componentPadding int
+	// levelPadding is the width the level is padded or truncated to for
+	// column alignment.
+	levelPadding int
+
+	// component is the Teleport subcomponent that is emitting the records,
+	// formatted and padded for output. Captured from attributes provided to
+	// WithAttrs.
+	component string
+	// preformatted is the data from attributes provided to WithAttrs,
+	// rendered once instead of per record.
+	preformatted []byte
+	// groupPrefix is the dot-separated concatenation of group names provided
+	// to WithGroup, prepended to attribute keys.
+	groupPrefix string
+
+	// mu protects out, fallback and writeFailure. It is shared by all clones
+	// of the handler.
+	mu  *sync.Mutex
+	out io.Writer
+	// fallback receives records whenever writing to out fails, so a transient
+	// output failure does not lose all logs.
+	fallback io.Writer
+	// writeFailure tracks the last failed write so repeated identical
+	// failures are surfaced only once. Shared by all clones of the handler.
+	writeFailure *writeFailureState
+}
+
+var _ ClosableHandler = (*SlogTextHandler)(nil)
+
+// writeFailureState holds the message of the last failed write to the primary
+// output. It is empty while the primary output is healthy.
+type writeFailureState struct {
+	message string
+}
+
+// SlogTextHandlerOption customizes a SlogTextHandler.
+type SlogTextHandlerOption func(*SlogTextHandler)
+
+// NewSlogTextHandler creates a SlogTextHandler that writes messages to w.
+func NewSlogTextHandler(w io.Writer, level slog.Leveler, enableColors bool, opts ...SlogTextHandlerOption) *SlogTextHandler {
+	s := &SlogTextHandler{
+		level:            level,
+		enableColors:     enableColors,
+		withTimestamp:    true,
+		withCaller:       true,
+		componentPadding: trace.DefaultComponentPadding,
+		levelPadding:     trace.DefaultLevelPadding,
+		mu:               &sync.Mutex{},
+		out:              w,
+		writeFailure:     &writeFailureState{},
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// NewSlogTextHandlerForOutput creates a SlogTextHandler that writes messages
+// to w with colors enabled only when w is an interactive terminal. Redirected
+// output and writers that are not an [os.File] get no ANSI escape codes. Use
+// [NewSlogTextHandler] to force colors on or off explicitly.
+func NewSlogTextHandlerForOutput(w io.Writer, level slog.Leveler, opts ...SlogTextHandlerOption) *SlogTextHandler {
+	return NewSlogTextHandler(w, level, trace.IsTerminal(w), opts...)
+}
+
+// Enabled returns whether the provided level will be included in output.
+// Note that records logged via a context produced by [WithLogSuppressed] are
+// never enabled, which requires a context value lookup per call.
+func (s *SlogTextHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	if isLogSuppressed(ctx) {
+		return false
+	}
+	minimumLevel := slog.LevelInfo
+	if s.level != nil {
+		minimumLevel = s.level.Level()
+	}
+	return level >= minimumLevel
+}
+
+// appendAttr formats the attribute and appends it to buf in key:value form.
+// Group attributes are flattened with a dot-separated key prefix.
+func (s *SlogTextHandler) appendAttr(buf []byte, a slog.Attr, groupPrefix string) []byte {
+	a.Value = a.Value.Resolve()
+	if a.Equal(slog.Attr{}) {
+		return buf
+	}
+
+	if a.Value.Kind() == slog.KindGroup {
+		attrs := a.Value.Group()
+		if len(attrs) == 0 {
+			return buf
+		}
+		if a.Key != "" {
+			groupPrefix = groupPrefix + a.Key + "."
+		}
+		for _, attr := range attrs {
+			buf = s.appendAttr(buf, attr, groupPrefix)
+		}
+		return buf
+	}
+
+	if len(buf) > 0 {
+		buf = append(buf, ' ')
+	}
+	buf = append(buf, groupPrefix...)
+	buf = append(buf, a.Key...)
+	buf = append(buf, ':')
+	return s.appendValue(buf, a.Key, a.Value)
+}
+
+// appendValue formats the value and appends it to buf.
+func (s *SlogTextHandler) appendValue(buf []byte, key string, value slog.Value) []byte {
+	if key == "error" {
+		if err, ok := value.Any().(error); ok {
+			return append(buf, fmt.Sprintf("[%v]", trace.DebugReport(err))...)
+		}
+	}
+
+	var str string
+	switch value.Kind() {
+	case slog.KindString:
+		str = value.String()
+		if needsQuoting(str) {
+			str = fmt.Sprintf("%q", str)
+		}
+	case slog.KindDuration:
+		str = value.Duration().String()
+	case slog.KindTime:
+		// Match the record timestamp format instead of the verbose default
+		// time.Time rendering.
+		str = value.Time().Format(time.RFC3339)
+	default:
+		str = fmt.Sprintf("%v", value.Any())
+	}
+	return append(buf, str...)
+}
+
+// formatComponent renders the component attribute in the padded, bracketed
+// form used by the text formatter.
+func formatComponent(value slog.Value, padding int) string {
+	component := fmt.Sprintf("[%v]", value.Any())
+	component = strings.ToUpper(padMax(component, padding))
+	if component[len(component)-1] != ' ' {
+		component = component[:len(component)-1] + "]"
+	}
+	return component
+}
+
+// Handle formats the record and writes the output to the destination.
+// Records that grow beyond largeRecordThreshold are streamed to the output in
+// chunks instead of being accumulated, bounding memory for records with very
+// large attribute values.
+func (s *SlogTextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if isLogSuppressed(ctx) {
+		return nil
+	}
+
+	buf := newBuffer()
+	defer buf.Free()
+
+	w := &chunkedWriter{handler: s, buf: buf}
+	defer w.release()
+
+	if s.withTimestamp && !r.Time.IsZero() {
+		*buf = append(*buf, r.Time.Format(time.RFC3339)...)
+	}
+
+	color := noColor
+	if s.enableColors {
+		color = levelColor(r.Level)
+	}
+	level := strings.ToUpper(padMax(levelText(r.Level), s.levelPadding))
+	if color != noColor {
+		level = fmt.Sprintf("\x1b[%dm%s\x1b[0m", color, level)
+	}
+	if len(*buf) > 0 {
+		*buf = append(*buf, ' ')
+	}
+	*buf = append(*buf, level...)
+
+	if s.component != "" {
+		*buf = append(*buf, ' ')
+		*buf = append(*buf, s.component...)
+	}
+
+	if r.Message != "" {
+		*buf = append(*buf, ' ')
+		*buf = append(*buf, r.Message...)
+	}
+
+	if len(s.preformatted) > 0 {
+		*buf = append(*buf, ' ')
+		*buf = append(*buf, s.preformatted...)
+	}
+
+	if s.deterministic {
+		var rendered []string
+		r.Attrs(func(a slog.Attr) bool {
+			if attr := s.appendAttr(nil, a, s.groupPrefix); len(attr) > 0 {
+				rendered = append(rendered, string(attr))
+			}
+			return true
+		})
+		sort.Strings(rendered)
+		for _, attr := range rendered {
+			*buf = append(*buf, ' ')
+			*buf = append(*buf, attr...)
+		}
+	} else {
+		var attrErr error
+		r.Attrs(func(a slog.Attr) bool {
+			*buf = s.appendAttr(*buf, a, s.groupPrefix)
+			if attrErr = w.spillIfLarge(); attrErr != nil {
+				return false
+			}
+			return true
+		})
+		if attrErr != nil {
+			return trace.Wrap(attrErr)
+		}
+	}
+
+	if s.withCaller {
+		caller := getCaller(r)
+		if s.deterministic {
+			caller = deterministicCaller
+		} else if s.callerFunctionDepth > 0 && caller != "" {
+			if function := getCallerFunction(r); function != "" {
+				caller = fmt.Sprintf("%s (%s)", callerFunction(function, s.callerFunctionDepth), caller)
+			}
+		}
+		if caller != "" {
+			*buf = append(*buf, ' ')
+			*buf = append(*buf, caller...)
+		}
+	}
+
+	*buf = append(*buf, '\n')
+
+	if err := w.spill(); err != nil {
+		return trace.Wrap(err)
+	}
+
+	// A process that logs at fatal is likely about to exit, so make sure the
+	// record reaches disk before a subsequent os.Exit discards buffered output.
+	if r.Level >= FatalLevel {
+		return trace.Wrap(syncOutput(s.out))
+	}
+	return nil
+}
+
+// chunkedWriter stages a record in the handler's pooled buffer and spills it
+// to the output when it grows beyond largeRecordThreshold. The output mutex
+// is acquired on the first spill and held until release so that concurrent
+// records do not interleave with a partially written one.
+type chunkedWriter struct {
+	handler *SlogTextHandler
+	buf     *buffer
+	locked  bool
+}
+
+// spillIfLarge writes out the buffered data if it exceeds the threshold.
+func (w *chunkedWriter) spillIfLarge() error {
+	if len(*w.buf) < largeRecordThreshold {
+		return nil
+	}
+	return w.spill()
+}
+
+// spill writes out the buffered data and resets the buffer.
+func (w *chunkedWriter) spill() error {
+	if !w.locked {
+		w.handler.mu.Lock()
+		w.locked = true
+	}
+	err := w.handler.write(*w.buf)
+	*w.buf = (*w.buf)[:0]
+	return trace.Wrap(err)
+}
+
+// write sends buf to the primary output, routing it to the fallback writer on
+// failure. Writing to the primary output is attempted every time, so the
+// handler resumes using it as soon as it recovers. Must be called with mu
+// held.
+func (s *SlogTextHandler) write(buf []byte) error {
+	_, err := s.out.Write(buf)
+	if err == nil {
+		s.writeFailure.message = ""
+		return nil
+	}
+	if s.fallback != nil {
+		// A failing fallback leaves us with nowhere to write, ignore it.
+		_, _ = s.fallback.Write(buf)
+	}
+	// Surface repeated identical failures only once so the logging pipeline
+	// does not flood with its own errors.
+	if s.writeFailure.message == err.Error() {
+		return nil
+	}
+	s.writeFailure.message = err.Error()
+	return trace.Wrap(err)
+}
+
+// release unlocks the output mutex if it is held.
+func (w *chunkedWriter) release() {
+	if w.locked {
+		w.handler.mu.Unlock()
+		w.locked = false
+	}
+}
+
+// syncOutput flushes and syncs the writer if it supports either operation.
+func syncOutput(out io.Writer) error {
+	if flusher, ok := out.(interface{ Flush() error }); ok {
+		if err := flusher.Flush(); err != nil {
+			return trace.Wrap(err)
+		}
+	}
+	if syncer, ok := out.(interface{ Sync() error }); ok {
+		return trace.Wrap(syncer.Sync())
+	}
+	return nil
+}
+
+// Close flushes the output writer if it is buffered. Records are always
+// newline-terminated when written, so a handler closed mid-stream leaves a
+// well-formed final record. Implements [ClosableHandler].
+func (s *SlogTextHandler) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return trace.Wrap(syncOutput(s.out))
+}
+
+// clone duplicates the handler. Clones share the output writer and its mutex.
+func (s *SlogTextHandler) clone() *SlogTextHandler {
+	clone := *s
+	return &clone
+}
+
+// WithAttrs returns a new handler whose attributes consist of both the
+// receiver's attributes and the arguments. Attributes are rendered once here
+// instead of per record.
+func (s *SlogTextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	if len(attrs) == 0 {
+		return s
+	}
+	clone := s.clone()
+	preformatted := make([]byte, len(s.preformatted), len(s.preformatted)+16*len(attrs))
+	copy(preformatted, s.preformatted)
+	for _, a := range attrs {
+		if a.Key == trace.Component && s.groupPrefix == "" {
+			clone.component = formatComponent(a.Value.Resolve(), s.componentPadding)
+			continue
+		}
+		preformatted = clone.appendAttr(preformatted, a, s.groupPrefix)
+	}
+	clone.preformatted = preformatted
+	return clone
+}
+
+// WithGroup returns a new handler that prefixes all attribute keys with the
+// provided group name.
+func (s *SlogTextHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return s
+	}
+	clone := s.clone()
+	clone.groupPrefix = s.groupPrefix + name + "."
+	return clone
+}
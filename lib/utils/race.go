@@ -0,0 +1,86 @@
+/*
+Copyright 2023 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"context"
+	"sync"
+
+	"github.com/gravitational/trace"
+)
+
+// RaceOpts configures RaceFirst.
+type RaceOpts struct {
+	// FastFail returns true for errors that should be surfaced immediately,
+	// canceling the remaining tasks, instead of being collected while waiting
+	// for another task to succeed.
+	FastFail func(error) bool
+}
+
+// RaceFirst runs the tasks concurrently and returns the first successful
+// result, canceling the remaining tasks and waiting for them to exit before
+// returning. Errors from failed tasks are collected and returned as an
+// aggregate only once every task has failed, unless an error matches
+// opts.FastFail, in which case it is surfaced immediately. Tasks must honor
+// cancellation of the context they are given or RaceFirst will not return
+// until they do.
+func RaceFirst[T any](ctx context.Context, tasks []func(context.Context) (T, error), opts RaceOpts) (T, error) {
+	var zero T
+	if len(tasks) == 0 {
+		return zero, trace.BadParameter("no tasks to run")
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	// Cancel the remaining tasks and wait for them to exit before returning.
+	// Deferred calls run last-in first-out, so the cancellation is delivered
+	// before the wait starts.
+	defer wg.Wait()
+	defer cancel()
+
+	type result struct {
+		value T
+		err   error
+	}
+	results := make(chan result, len(tasks))
+	for _, task := range tasks {
+		task := task
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			value, err := task(ctx)
+			results <- result{value: value, err: err}
+		}()
+	}
+
+	var errors []error
+	for i := 0; i < len(tasks); i++ {
+		select {
+		case res := <-results:
+			if res.err == nil {
+				return res.value, nil
+			}
+			if opts.FastFail != nil && opts.FastFail(res.err) {
+				return zero, trace.Wrap(res.err)
+			}
+			errors = append(errors, res.err)
+		case <-ctx.Done():
+			return zero, trace.Wrap(ctx.Err())
+		}
+	}
+	return zero, trace.NewAggregate(errors...)
+}
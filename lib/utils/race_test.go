@@ -0,0 +1,118 @@
+/*
+Copyright 2023 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gravitational/trace"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRaceFirst(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("first success wins and cancels the rest", func(t *testing.T) {
+		canceled := make(chan struct{})
+		tasks := []func(context.Context) (string, error){
+			func(ctx context.Context) (string, error) {
+				<-ctx.Done()
+				close(canceled)
+				return "", trace.Wrap(ctx.Err())
+			},
+			func(context.Context) (string, error) {
+				return "winner", nil
+			},
+		}
+		value, err := RaceFirst(ctx, tasks, RaceOpts{})
+		require.NoError(t, err)
+		require.Equal(t, "winner", value)
+		select {
+		case <-canceled:
+		default:
+			t.Error("losing task was not canceled")
+		}
+	})
+
+	t.Run("waits out failures for a later success", func(t *testing.T) {
+		tasks := []func(context.Context) (string, error){
+			func(context.Context) (string, error) {
+				return "", trace.Errorf("first failure")
+			},
+			func(context.Context) (string, error) {
+				time.Sleep(10 * time.Millisecond)
+				return "late winner", nil
+			},
+		}
+		value, err := RaceFirst(ctx, tasks, RaceOpts{})
+		require.NoError(t, err)
+		require.Equal(t, "late winner", value)
+	})
+
+	t.Run("aggregates errors when every task fails", func(t *testing.T) {
+		tasks := []func(context.Context) (string, error){
+			func(context.Context) (string, error) {
+				return "", trace.Errorf("first failure")
+			},
+			func(context.Context) (string, error) {
+				return "", trace.Errorf("second failure")
+			},
+		}
+		_, err := RaceFirst(ctx, tasks, RaceOpts{})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "first failure")
+		require.Contains(t, err.Error(), "second failure")
+	})
+
+	t.Run("fast-fail errors are surfaced immediately", func(t *testing.T) {
+		sentinel := errors.New("non-registered device")
+		tasks := []func(context.Context) (string, error){
+			func(ctx context.Context) (string, error) {
+				<-ctx.Done()
+				return "", trace.Wrap(ctx.Err())
+			},
+			func(context.Context) (string, error) {
+				return "", sentinel
+			},
+		}
+		_, err := RaceFirst(ctx, tasks, RaceOpts{
+			FastFail: func(err error) bool { return errors.Is(err, sentinel) },
+		})
+		require.ErrorIs(t, err, sentinel)
+	})
+
+	t.Run("honors context cancellation", func(t *testing.T) {
+		cancelCtx, cancel := context.WithCancel(ctx)
+		cancel()
+		tasks := []func(context.Context) (string, error){
+			func(ctx context.Context) (string, error) {
+				<-ctx.Done()
+				return "", trace.Wrap(ctx.Err())
+			},
+		}
+		_, err := RaceFirst(cancelCtx, tasks, RaceOpts{})
+		require.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("no tasks", func(t *testing.T) {
+		_, err := RaceFirst[string](ctx, nil, RaceOpts{})
+		require.True(t, trace.IsBadParameter(err))
+	})
+}